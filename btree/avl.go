@@ -0,0 +1,73 @@
+package btree
+
+import "github.com/johannessarpola/gollections/internal/node"
+
+// height returns the cached height of the subtree, 0 for nil.
+func height[T any](n *node.TreeNode[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.Height
+}
+
+// size returns the cached value count of the subtree, 0 for nil.
+func size[T any](n *node.TreeNode[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.Size
+}
+
+// update recomputes the cached height and size of the node from its children.
+func update[T any](n *node.TreeNode[T]) {
+	n.Height = max(height(n.Prev), height(n.Next)) + 1
+	n.Size = size(n.Prev) + size(n.Next) + n.Count
+}
+
+// balanceFactor is positive when the left subtree is higher and negative when the right one is.
+func balanceFactor[T any](n *node.TreeNode[T]) int {
+	return height(n.Prev) - height(n.Next)
+}
+
+// rotateRight lifts the left child of root in its place and returns the new root.
+func rotateRight[T any](root *node.TreeNode[T]) *node.TreeNode[T] {
+	pivot := root.Prev
+	root.Prev = pivot.Next
+	pivot.Next = root
+
+	update(root)
+	update(pivot)
+	return pivot
+}
+
+// rotateLeft lifts the right child of root in its place and returns the new root.
+func rotateLeft[T any](root *node.TreeNode[T]) *node.TreeNode[T] {
+	pivot := root.Next
+	root.Next = pivot.Prev
+	pivot.Prev = root
+
+	update(root)
+	update(pivot)
+	return pivot
+}
+
+// rebalance restores the AVL property for root, assuming its subtrees are balanced.
+func rebalance[T any](root *node.TreeNode[T]) *node.TreeNode[T] {
+	update(root)
+	bf := balanceFactor(root)
+
+	switch {
+	case bf > 1: // left heavy
+		if balanceFactor(root.Prev) < 0 {
+			root.Prev = rotateLeft(root.Prev) // left-right case
+		}
+		return rotateRight(root)
+	case bf < -1: // right heavy
+		if balanceFactor(root.Next) > 0 {
+			root.Next = rotateRight(root.Next) // right-left case
+		}
+		return rotateLeft(root)
+	}
+
+	return root
+}
@@ -0,0 +1,130 @@
+package btree
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/johannessarpola/gollections/internal/node"
+)
+
+// isAVL checks that every node is balanced and caches the correct height.
+func isAVL[T any](n *node.TreeNode[T]) (int, bool) {
+	if n == nil {
+		return 0, true
+	}
+	lh, lok := isAVL(n.Prev)
+	rh, rok := isAVL(n.Next)
+	h := max(lh, rh) + 1
+
+	if !lok || !rok || lh-rh > 1 || rh-lh > 1 || n.Height != h {
+		return h, false
+	}
+	return h, true
+}
+
+func TestBinaryTree_SelfBalancing(t *testing.T) {
+	sorted := make([]int, 1000)
+	for i := range sorted {
+		sorted[i] = i
+	}
+	reversed := slices.Clone(sorted)
+	slices.Reverse(reversed)
+
+	tests := []struct {
+		name  string
+		input []int
+	}{
+		{name: "avl-1", input: sorted},
+		{name: "avl-2", input: reversed},
+		{name: "avl-3", input: []int{5, 3, 7, 2, 4, 6, 8}},
+		{name: "avl-4", input: []int{10, 20, 15, 30, 25, 5, 1, 3, 2}},
+		{name: "avl-5", input: []int{1, 1, 1, 1, 1}},
+		{name: "avl-6", input: []int{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bt := NewBinaryTree[int](WithSelfBalancing())
+			bt.Insert(test.input...)
+
+			if _, ok := isAVL(bt.head); !ok {
+				t.Errorf("tree is not balanced:\n%s", bt.String())
+			}
+
+			maxHeight := int(1.45*math.Log2(float64(len(test.input)+2))) + 1
+			if bt.Height() > maxHeight {
+				t.Errorf("height %d exceeds %d", bt.Height(), maxHeight)
+			}
+
+			var rs []int
+			for _, v := range bt.InOrder {
+				rs = append(rs, v)
+			}
+			want := slices.Clone(test.input)
+			slices.Sort(want)
+			if len(want) > 0 && !reflect.DeepEqual(rs, want) {
+				t.Errorf("got %v, want %v", rs, want)
+			}
+
+			for _, v := range test.input {
+				if _, ok := bt.Search(v); !ok {
+					t.Errorf("expected %v to be found", v)
+				}
+			}
+		})
+	}
+}
+
+func TestBinaryTree_SelfBalancingRotations(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{name: "rotate-right", input: []int{3, 2, 1}, expected: []int{2, 1, 3}},
+		{name: "rotate-left", input: []int{1, 2, 3}, expected: []int{2, 1, 3}},
+		{name: "rotate-left-right", input: []int{3, 1, 2}, expected: []int{2, 1, 3}},
+		{name: "rotate-right-left", input: []int{1, 3, 2}, expected: []int{2, 1, 3}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bt := NewBinaryTree[int](WithSelfBalancing())
+			bt.Insert(test.input...)
+
+			var rs []int
+			for _, v := range bt.PreOrder {
+				rs = append(rs, v)
+			}
+			if !reflect.DeepEqual(rs, test.expected) {
+				t.Errorf("got %v, want %v", rs, test.expected)
+			}
+		})
+	}
+}
+
+func TestBinaryTree_SelfBalancingJSON(t *testing.T) {
+	bt := NewBinaryTree[int](WithSelfBalancing(), WithTraversalOrder(InOrder))
+	bt.Insert(1, 2, 3, 4, 5, 6, 7)
+
+	d, err := json.Marshal(&bt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nbt := NewBinaryTree[int]()
+	if err := json.Unmarshal(d, &nbt); err != nil {
+		t.Fatal(err)
+	}
+
+	if !nbt.IsSelfBalancing() {
+		t.Errorf("expected unmarshalled tree to be self balancing")
+	}
+	if nbt.Height() != bt.Height() {
+		t.Errorf("got height %d, want %d", nbt.Height(), bt.Height())
+	}
+	if !reflect.DeepEqual(nbt.Items(), bt.Items()) {
+		t.Errorf("got %v, want %v", nbt.Items(), bt.Items())
+	}
+}
@@ -4,18 +4,26 @@ import (
 	"cmp"
 	"encoding/json"
 	"fmt"
-	"slices"
+	"iter"
 	"strings"
 	"sync"
 	"sync/atomic"
 
 	"github.com/johannessarpola/gollections/internal/node"
+	"github.com/johannessarpola/gollections/internal/ordering"
+	"github.com/johannessarpola/gollections/internal/seqs"
 )
 
-type BinaryTree[T cmp.Ordered] struct {
-	head           *node.Node[T]
+// BinaryTree is a binary search tree ordered by its comparator. A zero value tree, for example one
+// created by unmarshalling into a struct field, orders its values by their natural order and panics
+// on the first Insert or lookup when T is not an ordered type.
+type BinaryTree[T any] struct {
+	head           *node.TreeNode[T]
+	compare        func(a, b T) int // ordering of the values
 	mu             sync.Mutex
-	traversalOrder TraversalOrder // used for json
+	traversalOrder TraversalOrder  // used for json
+	selfBalancing  bool            // keeps the tree AVL balanced on insert
+	duplicates     DuplicatePolicy // how equal values are inserted
 }
 
 const DefaultTraversal TraversalOrder = PreOrder
@@ -44,109 +52,368 @@ func (bt *BinaryTree[T]) resolveTravelsalFunc(travelsalOrder TraversalOrder) fun
 	}
 }
 
-func NewBinaryTree[T cmp.Ordered]() BinaryTree[T] {
-	return BinaryTree[T]{}
+const DefaultDuplicates DuplicatePolicy = AllowDuplicates
+
+// DuplicatePolicy decides what Insert does with a value equal to one already in the tree.
+type DuplicatePolicy = string
+
+const (
+	AllowDuplicates   DuplicatePolicy = "allow"   // equal values are stored as separate nodes
+	RejectDuplicates  DuplicatePolicy = "reject"  // equal values are ignored, the tree is a sorted set
+	CountDuplicates   DuplicatePolicy = "count"   // equal values increase the multiplicity of the node, the tree is a sorted bag
+	ReplaceDuplicates DuplicatePolicy = "replace" // equal values replace the stored value
+)
+
+// TreeOpts holds the configuration for a BinaryTree.
+type TreeOpts struct {
+	Order         TraversalOrder  // traversal order used for json
+	SelfBalancing bool            // rebalance the tree on insert
+	Duplicates    DuplicatePolicy // how equal values are inserted
+}
+
+// TreeOpt represents a functional option for configuring the tree.
+type TreeOpt func(*TreeOpts)
+
+// WithTraversalOrder sets the traversal order used for json and Items.
+func WithTraversalOrder(order TraversalOrder) TreeOpt {
+	return func(o *TreeOpts) {
+		o.Order = order
+	}
+}
+
+// WithSelfBalancing makes the tree keep itself AVL balanced, so insert and search stay O(log n).
+func WithSelfBalancing() TreeOpt {
+	return func(o *TreeOpts) {
+		o.SelfBalancing = true
+	}
+}
+
+// WithDuplicates sets the policy for inserting values equal to ones already in the tree.
+// It has no effect on a TreeMap, which always replaces the value of an existing key.
+func WithDuplicates(policy DuplicatePolicy) TreeOpt {
+	return func(o *TreeOpts) {
+		o.Duplicates = policy
+	}
+}
+
+func argHandler(opts []TreeOpt) TreeOpts {
+	args := TreeOpts{}
+	for _, opt := range opts {
+		opt(&args)
+	}
+	return args
+}
+
+func NewBinaryTree[T cmp.Ordered](opts ...TreeOpt) BinaryTree[T] {
+	return NewBinaryTreeFunc(cmp.Compare[T], opts...)
+}
+
+// NewBinaryTreeFunc creates a tree which orders its values with compare,
+// compare returns a negative number when a < b, zero when a == b and a positive number when a > b.
+func NewBinaryTreeFunc[T any](compare func(a, b T) int, opts ...TreeOpt) BinaryTree[T] {
+	args := argHandler(opts)
+	return BinaryTree[T]{
+		compare:        compare,
+		traversalOrder: args.Order,
+		selfBalancing:  args.SelfBalancing,
+		duplicates:     args.Duplicates,
+	}
+}
+
+// FromSeq creates a new tree with the values of seq inserted in order.
+func FromSeq[T cmp.Ordered](seq iter.Seq[T], opts ...TreeOpt) BinaryTree[T] {
+	return FromSeqFunc(seq, cmp.Compare[T], opts...)
+}
+
+// FromSeqFunc creates a new tree ordered by compare with the values of seq inserted in order.
+func FromSeqFunc[T any](seq iter.Seq[T], compare func(a, b T) int, opts ...TreeOpt) BinaryTree[T] {
+	args := argHandler(opts)
+
+	var head *node.TreeNode[T]
+	for v := range seq {
+		head = insert(head, v, compare, args.SelfBalancing, args.Duplicates)
+	}
+
+	return BinaryTree[T]{
+		head:           head,
+		compare:        compare,
+		traversalOrder: args.Order,
+		selfBalancing:  args.SelfBalancing,
+		duplicates:     args.Duplicates,
+	}
 }
 
 func NewBinaryTreeWithOrder[T cmp.Ordered](order TraversalOrder) BinaryTree[T] {
 	return BinaryTree[T]{
+		compare:        cmp.Compare[T],
 		traversalOrder: order,
 	}
 }
 
 func NewBinaryTreeWithType[T cmp.Ordered](typeOf T, order TraversalOrder) BinaryTree[T] {
 	return BinaryTree[T]{
+		compare:        cmp.Compare[T],
 		traversalOrder: order,
 	}
 }
 
+// comparator returns the ordering of the tree, resolving the natural ordering of T once
+// for trees which were not created with a constructor. Callers must hold the lock.
+func (bt *BinaryTree[T]) comparator() func(a, b T) int {
+	if bt.compare == nil {
+		compare, ok := ordering.Natural[T]()
+		if !ok {
+			panic(fmt.Sprintf("no comparator for type %T, use NewBinaryTreeFunc", *new(T)))
+		}
+		bt.compare = compare
+	}
+	return bt.compare
+}
+
 func (bt *BinaryTree[T]) withLock(f func()) {
 	defer bt.mu.Unlock()
 	bt.mu.Lock()
 	f()
 }
 
-func insert[T cmp.Ordered](root *node.Node[T], value T) *node.Node[T] {
-	n := node.NewNode(value)
+func insert[T any](root *node.TreeNode[T], value T, compare func(T, T) int, balance bool, duplicates DuplicatePolicy) *node.TreeNode[T] {
 	if root == nil {
+		n := node.NewTreeNode(value)
 		return &n
 	}
-	v, _ := root.Get()
-	if value < v {
-		root.Prev = insert(root.Prev, value)
+
+	c := compare(value, root.Inner)
+	if c == 0 {
+		switch duplicates {
+		case RejectDuplicates:
+			return root
+		case ReplaceDuplicates:
+			root.Inner = value
+			return root
+		case CountDuplicates:
+			root.Count++
+			return fixup(root, balance)
+		}
+	}
+
+	// equal values are placed into the right subtree when duplicates are allowed
+	if c < 0 {
+		root.Prev = insert(root.Prev, value, compare, balance, duplicates)
 	} else {
-		root.Next = insert(root.Next, value)
+		root.Next = insert(root.Next, value, compare, balance, duplicates)
 	}
 
+	return fixup(root, balance)
+}
+
+// fixup updates the cached height of root and rebalances it if balance is set.
+func fixup[T any](root *node.TreeNode[T], balance bool) *node.TreeNode[T] {
+	if balance {
+		return rebalance(root)
+	}
+	update(root)
 	return root
 }
 
+// removeMin detaches the leftmost node of the subtree, returns the new root and the detached node.
+func removeMin[T any](root *node.TreeNode[T], balance bool) (*node.TreeNode[T], *node.TreeNode[T]) {
+	if root.Prev == nil {
+		return root.Next, root
+	}
+	var m *node.TreeNode[T]
+	root.Prev, m = removeMin(root.Prev, balance)
+	return fixup(root, balance), m
+}
+
+// dropMin removes a single occurrence of the smallest value, returns the new root and the value.
+func dropMin[T any](root *node.TreeNode[T], balance bool) (*node.TreeNode[T], T) {
+	if root.Prev == nil {
+		if root.Count > 1 {
+			root.Count--
+			return fixup(root, balance), root.Inner
+		}
+		return root.Next, root.Inner
+	}
+	var v T
+	root.Prev, v = dropMin(root.Prev, balance)
+	return fixup(root, balance), v
+}
+
+// dropMax removes a single occurrence of the largest value, returns the new root and the value.
+func dropMax[T any](root *node.TreeNode[T], balance bool) (*node.TreeNode[T], T) {
+	if root.Next == nil {
+		if root.Count > 1 {
+			root.Count--
+			return fixup(root, balance), root.Inner
+		}
+		return root.Prev, root.Inner
+	}
+	var v T
+	root.Next, v = dropMax(root.Next, balance)
+	return fixup(root, balance), v
+}
+
+// remove deletes a node holding value and returns the new root, bool is false if value was not found.
+func remove[T any](root *node.TreeNode[T], value T, compare func(T, T) int, balance bool) (*node.TreeNode[T], bool) {
+	if root == nil {
+		return nil, false
+	}
+
+	var removed bool
+	switch c := compare(value, root.Inner); {
+	case c < 0:
+		root.Prev, removed = remove(root.Prev, value, compare, balance)
+	case c > 0:
+		root.Next, removed = remove(root.Next, value, compare, balance)
+	default:
+		// multiset node, only the multiplicity decreases
+		if root.Count > 1 {
+			root.Count--
+			return fixup(root, balance), true
+		}
+
+		// leaf or a single child, the child takes the place of the node
+		if root.Prev == nil {
+			return root.Next, true
+		}
+		if root.Next == nil {
+			return root.Prev, true
+		}
+
+		// two children, replace with the in-order successor
+		var successor *node.TreeNode[T]
+		root.Next, successor = removeMin(root.Next, balance)
+		root.Inner, root.Count = successor.Inner, successor.Count
+		removed = true
+	}
+
+	if !removed {
+		return root, false
+	}
+	return fixup(root, balance), true
+}
+
 func (bt *BinaryTree[T]) Insert(values ...T) {
 	bt.withLock(func() {
 		for _, v := range values {
-			bt.head = insert(bt.head, v)
+			bt.head = insert(bt.head, v, bt.comparator(), bt.selfBalancing, bt.duplicates)
 		}
 	})
 }
 
-func preOrder[T cmp.Ordered](root *node.Node[T], i *atomic.Int32, yield func(int, T) bool) {
+// Delete removes a single occurrence of value from the tree, returns false if it was not found.
+func (bt *BinaryTree[T]) Delete(value T) bool {
+	b := false
+	bt.withLock(func() {
+		bt.head, b = remove(bt.head, value, bt.comparator(), bt.selfBalancing)
+	})
+	return b
+}
+
+// DeleteMin removes and returns the smallest value of the tree.
+func (bt *BinaryTree[T]) DeleteMin() (T, bool) {
+	var (
+		rs T
+		b  bool
+	)
+	bt.withLock(func() {
+		if bt.head == nil {
+			return
+		}
+		bt.head, rs = dropMin(bt.head, bt.selfBalancing)
+		b = true
+	})
+	return rs, b
+}
+
+// DeleteMax removes and returns the largest value of the tree.
+func (bt *BinaryTree[T]) DeleteMax() (T, bool) {
+	var (
+		rs T
+		b  bool
+	)
+	bt.withLock(func() {
+		if bt.head == nil {
+			return
+		}
+		bt.head, rs = dropMax(bt.head, bt.selfBalancing)
+		b = true
+	})
+	return rs, b
+}
+
+// IsSelfBalancing returns true if the tree rebalances itself on insert.
+func (bt *BinaryTree[T]) IsSelfBalancing() bool {
+	return bt.selfBalancing
+}
+
+// yieldNode yields the value of the node once per its multiplicity.
+func yieldNode[T any](n *node.TreeNode[T], i *atomic.Int32, yield func(int, T) bool) bool {
+	for range n.Count {
+		index := int(i.Add(1) - 1)
+		if !yield(index, n.Inner) {
+			return false
+		}
+	}
+	return true
+}
+
+// preOrder returns false if the traversal was stopped by yield.
+func preOrder[T any](root *node.TreeNode[T], i *atomic.Int32, yield func(int, T) bool) bool {
 	if root == nil {
-		return
+		return true
 	}
 
 	// Yield the current node
-	index := int(i.Add(1) - 1)
-	if !yield(index, root.Inner) {
-		return
+	if !yieldNode(root, i, yield) {
+		return false
 	}
 
-	// Traverse the left subtree
-	preOrder(root.Prev, i, yield)
-
-	// Traverse the right subtree
-	preOrder(root.Next, i, yield)
+	// Traverse the left subtree, then the right subtree
+	return preOrder(root.Prev, i, yield) && preOrder(root.Next, i, yield)
 }
 
-func inOrder[T cmp.Ordered](root *node.Node[T], i *atomic.Int32, yield func(int, T) bool) {
+// inOrder returns false if the traversal was stopped by yield.
+func inOrder[T any](root *node.TreeNode[T], i *atomic.Int32, yield func(int, T) bool) bool {
 	if root == nil {
-		return
+		return true
 	}
 
-	inOrder(root.Prev, i, yield)
+	if !inOrder(root.Prev, i, yield) {
+		return false
+	}
 
 	// Yield the current node
-	index := int(i.Add(1) - 1)
-	if !yield(index, root.Inner) {
-		return
+	if !yieldNode(root, i, yield) {
+		return false
 	}
 
-	inOrder(root.Next, i, yield)
+	return inOrder(root.Next, i, yield)
 }
 
-func postOrder[T cmp.Ordered](root *node.Node[T], i *atomic.Int32, yield func(int, T) bool) {
+// postOrder returns false if the traversal was stopped by yield.
+func postOrder[T any](root *node.TreeNode[T], i *atomic.Int32, yield func(int, T) bool) bool {
 	if root == nil {
-		return
+		return true
 	}
 
-	postOrder(root.Prev, i, yield)
+	if !postOrder(root.Prev, i, yield) || !postOrder(root.Next, i, yield) {
+		return false
+	}
 
-	postOrder(root.Next, i, yield)
 	// Yield the current node
-	index := int(i.Add(1) - 1)
-	if !yield(index, root.Inner) {
-		return
-	}
+	return yieldNode(root, i, yield)
 }
 
-func levelOrder[T cmp.Ordered](root *node.Node[T], yield func(int, T) bool) {
+func levelOrder[T any](root *node.TreeNode[T], yield func(int, T) bool) {
 	if root == nil {
 		return
 	}
 
-	queue := make([]*node.Node[T], 0)
+	queue := make([]*node.TreeNode[T], 0)
 	queue = append(queue, root)
 
-	i := 0
+	i := &atomic.Int32{}
 
 	for len(queue) > 0 {
 		// dequeue the first currentNode
@@ -154,10 +421,9 @@ func levelOrder[T cmp.Ordered](root *node.Node[T], yield func(int, T) bool) {
 		queue = queue[1:]       // remove first element
 
 		// yield the currentNode value
-		if !yield(i, currentNode.Inner) {
+		if !yieldNode(currentNode, i, yield) {
 			return
 		}
-		i++
 
 		// add left child to the queue
 		if currentNode.Prev != nil {
@@ -171,81 +437,99 @@ func levelOrder[T cmp.Ordered](root *node.Node[T], yield func(int, T) bool) {
 	}
 }
 
-// treeHeight computes the height of the binary tree.
-func treeHeight[T comparable](root *node.Node[T]) int {
-	if root == nil {
-		return 0
-	}
-	leftHeight := treeHeight(root.Prev)
-	rightHeight := treeHeight(root.Next)
-
-	// The height of the tree is the maximum of the two subtrees + 1 (for the current root).
-	if leftHeight > rightHeight {
-		return leftHeight + 1
+// traverse walks the tree rooted at root in the given order.
+func traverse[T any](root *node.TreeNode[T], order TraversalOrder, yield func(int, T) bool) {
+	switch order {
+	case InOrder:
+		inOrder(root, &atomic.Int32{}, yield)
+	case PreOrder:
+		preOrder(root, &atomic.Int32{}, yield)
+	case PostOrder:
+		postOrder(root, &atomic.Int32{}, yield)
+	case LevelOrder:
+		levelOrder(root, yield)
+	default:
+		panic(fmt.Sprintf("unknown travelsal order %v", order))
 	}
-	return rightHeight + 1
 }
 
-// Postorder left-root-right
-func (bt *BinaryTree[T]) Postorder(yield func(int, T) bool) {
+// snapshot copies the values in the given order while holding the lock.
+func (bt *BinaryTree[T]) snapshot(order TraversalOrder) []T {
+	var values []T
 	bt.withLock(func() {
-		postOrder(bt.head, &atomic.Int32{}, yield)
+		traverse(bt.head, order, func(_ int, v T) bool {
+			values = append(values, v)
+			return true
+		})
 	})
+	return values
+}
+
+// yieldSnapshot yields a snapshot of the tree in the given order.
+func (bt *BinaryTree[T]) yieldSnapshot(order TraversalOrder, yield func(int, T) bool) {
+	seqs.Snapshot(func() []T { return bt.snapshot(order) })(yield)
+}
+
+// Postorder left-right-root
+func (bt *BinaryTree[T]) Postorder(yield func(int, T) bool) {
+	bt.yieldSnapshot(PostOrder, yield)
 }
 
 // InOrder left-root-right
 func (bt *BinaryTree[T]) InOrder(yield func(int, T) bool) {
-	bt.withLock(func() {
-		inOrder(bt.head, &atomic.Int32{}, yield)
-	})
+	bt.yieldSnapshot(InOrder, yield)
 }
 
 // PreOrder root-left-right
 func (bt *BinaryTree[T]) PreOrder(yield func(int, T) bool) {
-	bt.withLock(func() {
-		preOrder(bt.head, &atomic.Int32{}, yield)
-	})
+	bt.yieldSnapshot(PreOrder, yield)
 }
 
 // LeverOrder breadth first
 func (bt *BinaryTree[T]) LeverOrder(yield func(int, T) bool) {
-	bt.withLock(func() {
-		levelOrder(bt.head, yield)
-	})
+	bt.yieldSnapshot(LevelOrder, yield)
 }
 
 func (bt *BinaryTree[T]) Height() int {
-	return treeHeight(bt.head)
+	h := 0
+	bt.withLock(func() {
+		h = height(bt.head)
+	})
+	return h
 }
 
-// balanceTree builds a balanced binary tree from the sorted slice
-func balanceTree[T comparable](values []T, start, end int) *node.Node[T] {
+// balanceTree builds a balanced binary tree from the sorted nodes
+func balanceTree[T any](nodes []*node.TreeNode[T], start, end int) *node.TreeNode[T] {
 	if start > end {
 		return nil
 	}
 
 	// middle element as the root
 	mid := (start + end) / 2
-	node := &node.Node[T]{
-		Inner: values[mid],
-	}
+	root := nodes[mid]
 
 	// recursively build the left and right subtrees
-	node.Prev = balanceTree[T](values, start, mid-1)
-	node.Next = balanceTree[T](values, mid+1, end)
+	root.Prev = balanceTree(nodes, start, mid-1)
+	root.Next = balanceTree(nodes, mid+1, end)
+	update(root)
 
-	return node
+	return root
 }
 
-func (bt *BinaryTree[T]) Balance() {
-	var slice []T
-	for _, v := range bt.InOrder {
-		slice = append(slice, v)
+// collect appends the nodes of the tree in-order.
+func collect[T any](root *node.TreeNode[T], nodes []*node.TreeNode[T]) []*node.TreeNode[T] {
+	if root == nil {
+		return nodes
 	}
-	slices.Sort(slice)
+	nodes = collect(root.Prev, nodes)
+	nodes = append(nodes, root)
+	return collect(root.Next, nodes)
+}
 
+func (bt *BinaryTree[T]) Balance() {
 	bt.withLock(func() {
-		bt.head = balanceTree(slice, 0, len(slice)-1)
+		nodes := collect(bt.head, nil)
+		bt.head = balanceTree(nodes, 0, len(nodes)-1)
 	})
 }
 
@@ -264,7 +548,7 @@ func (bt *BinaryTree[T]) String() string {
 	return sb.String()
 }
 
-func find[T comparable](root *node.Node[T], predicate func(T, T) bool) (T, bool) {
+func find[T any](root *node.TreeNode[T], predicate func(T, T) bool) (T, bool) {
 	var zv T
 	if root == nil {
 		return zv, false
@@ -284,28 +568,26 @@ func find[T comparable](root *node.Node[T], predicate func(T, T) bool) (T, bool)
 	return best, true
 }
 
+// FindMax returns the largest value by following the rightmost path.
 func (bt *BinaryTree[T]) FindMax() (T, bool) {
 	var (
 		rs T
 		b  bool
 	)
 	bt.withLock(func() {
-		rs, b = find(bt.head, func(nv T, ov T) bool {
-			return nv > ov
-		})
+		rs, b = rightmost(bt.head).Get()
 	})
 	return rs, b
 }
 
+// FindMin returns the smallest value by following the leftmost path.
 func (bt *BinaryTree[T]) FindMin() (T, bool) {
 	var (
 		rs T
 		b  bool
 	)
 	bt.withLock(func() {
-		rs, b = find(bt.head, func(nv T, ov T) bool {
-			return nv < ov
-		})
+		rs, b = leftmost(bt.head).Get()
 	})
 	return rs, b
 }
@@ -321,32 +603,163 @@ func (bt *BinaryTree[T]) Find(predicate func(T, T) bool) (T, bool) {
 	return rs, b
 }
 
-func search[T cmp.Ordered](root *node.Node[T], target T) (T, bool) {
-	var zv T
+// lookup returns the node holding a value equal to target or nil.
+func lookup[T any](root *node.TreeNode[T], target T, compare func(T, T) int) *node.TreeNode[T] {
+	for current := root; current != nil; {
+		c := compare(target, current.Inner)
+		if c == 0 {
+			return current
+		}
+		if c < 0 {
+			current = current.Prev
+		} else {
+			current = current.Next
+		}
+	}
+	return nil
+}
 
-	if root == nil {
-		return zv, false
+func search[T any](root *node.TreeNode[T], target T, compare func(T, T) int) (T, bool) {
+	return lookup(root, target, compare).Get()
+}
+
+// floor returns the node with the greatest value less than or equal to target.
+func floor[T any](root *node.TreeNode[T], target T, compare func(T, T) int) *node.TreeNode[T] {
+	var best *node.TreeNode[T]
+	for current := root; current != nil; {
+		c := compare(target, current.Inner)
+		if c == 0 {
+			return current
+		}
+		if c < 0 {
+			current = current.Prev
+		} else {
+			best, current = current, current.Next
+		}
 	}
+	return best
+}
 
-	if root.Inner == target {
-		return root.Inner, true
+// ceiling returns the node with the smallest value greater than or equal to target.
+func ceiling[T any](root *node.TreeNode[T], target T, compare func(T, T) int) *node.TreeNode[T] {
+	var best *node.TreeNode[T]
+	for current := root; current != nil; {
+		c := compare(target, current.Inner)
+		if c == 0 {
+			return current
+		}
+		if c > 0 {
+			current = current.Next
+		} else {
+			best, current = current, current.Prev
+		}
 	}
+	return best
+}
 
-	if target < root.Inner {
-		lrs, lb := search(root.Prev, target)
-		if lb {
-			return lrs, true
+// lower returns the node with the greatest value strictly less than target.
+func lower[T any](root *node.TreeNode[T], target T, compare func(T, T) int) *node.TreeNode[T] {
+	var best *node.TreeNode[T]
+	for current := root; current != nil; {
+		if compare(current.Inner, target) < 0 {
+			best, current = current, current.Next
+		} else {
+			current = current.Prev
 		}
 	}
+	return best
+}
 
-	if target > root.Inner {
-		rrs, rb := search(root.Next, target)
-		if rb {
-			return rrs, true
+// higher returns the node with the smallest value strictly greater than target.
+func higher[T any](root *node.TreeNode[T], target T, compare func(T, T) int) *node.TreeNode[T] {
+	var best *node.TreeNode[T]
+	for current := root; current != nil; {
+		if compare(current.Inner, target) > 0 {
+			best, current = current, current.Prev
+		} else {
+			current = current.Next
 		}
 	}
+	return best
+}
 
-	return zv, false
+// leftmost returns the node with the smallest value.
+func leftmost[T any](root *node.TreeNode[T]) *node.TreeNode[T] {
+	if root == nil {
+		return nil
+	}
+	for root.Prev != nil {
+		root = root.Prev
+	}
+	return root
+}
+
+// rightmost returns the node with the largest value.
+func rightmost[T any](root *node.TreeNode[T]) *node.TreeNode[T] {
+	if root == nil {
+		return nil
+	}
+	for root.Next != nil {
+		root = root.Next
+	}
+	return root
+}
+
+// rank counts the values strictly less than target.
+func rank[T any](root *node.TreeNode[T], target T, compare func(T, T) int) int {
+	r := 0
+	for current := root; current != nil; {
+		if compare(current.Inner, target) < 0 {
+			r += size(current.Prev) + current.Count
+			current = current.Next
+		} else {
+			current = current.Prev
+		}
+	}
+	return r
+}
+
+// selectAt returns the node holding the k-th smallest value (zero based).
+func selectAt[T any](root *node.TreeNode[T], k int) *node.TreeNode[T] {
+	for current := root; current != nil; {
+		ls := size(current.Prev)
+		switch {
+		case k < ls:
+			current = current.Prev
+		case k < ls+current.Count:
+			return current
+		default:
+			k -= ls + current.Count
+			current = current.Next
+		}
+	}
+	return nil
+}
+
+// between yields in-order the values which fall in the range [lo, hi).
+func between[T any](root *node.TreeNode[T], lo, hi T, compare func(T, T) int, yield func(T) bool) bool {
+	if root == nil {
+		return true
+	}
+
+	aboveLo := compare(root.Inner, lo) >= 0
+	belowHi := compare(root.Inner, hi) < 0
+
+	// the left subtree can only contain values in range if the root is above lo
+	if aboveLo && !between(root.Prev, lo, hi, compare, yield) {
+		return false
+	}
+	if aboveLo && belowHi {
+		for range root.Count {
+			if !yield(root.Inner) {
+				return false
+			}
+		}
+	}
+	if belowHi {
+		return between(root.Next, lo, hi, compare, yield)
+	}
+	return true
 }
 
 func (bt *BinaryTree[T]) Search(element T) (T, bool) {
@@ -355,14 +768,122 @@ func (bt *BinaryTree[T]) Search(element T) (T, bool) {
 		b  bool
 	)
 	bt.withLock(func() {
-		rs, b = search(bt.head, element)
+		rs, b = search(bt.head, element, bt.comparator())
+	})
+
+	return rs, b
+}
+
+// nearest returns the value of the node resolved by f for the element.
+func (bt *BinaryTree[T]) nearest(element T, f func(*node.TreeNode[T], T, func(T, T) int) *node.TreeNode[T]) (T, bool) {
+	var (
+		rs T
+		b  bool
+	)
+	bt.withLock(func() {
+		rs, b = f(bt.head, element, bt.comparator()).Get()
 	})
+	return rs, b
+}
+
+// Floor returns the greatest value less than or equal to element.
+func (bt *BinaryTree[T]) Floor(element T) (T, bool) {
+	return bt.nearest(element, floor)
+}
+
+// Ceiling returns the smallest value greater than or equal to element.
+func (bt *BinaryTree[T]) Ceiling(element T) (T, bool) {
+	return bt.nearest(element, ceiling)
+}
+
+// Lower returns the greatest value strictly less than element.
+func (bt *BinaryTree[T]) Lower(element T) (T, bool) {
+	return bt.nearest(element, lower)
+}
+
+// Higher returns the smallest value strictly greater than element.
+func (bt *BinaryTree[T]) Higher(element T) (T, bool) {
+	return bt.nearest(element, higher)
+}
+
+// Range iterates in ascending order the values in the range [lo, hi).
+func (bt *BinaryTree[T]) Range(lo, hi T) iter.Seq[T] {
+	return seqs.SnapshotValues(func() []T {
+		var values []T
+		bt.withLock(func() {
+			between(bt.head, lo, hi, bt.comparator(), func(v T) bool {
+				values = append(values, v)
+				return true
+			})
+		})
+		return values
+	})
+}
+
+// count returns the number of values equal to target, equal values may be spread over
+// both subtrees of a matching node when duplicates are allowed.
+func count[T any](root *node.TreeNode[T], target T, compare func(T, T) int) int {
+	if root == nil {
+		return 0
+	}
+	c := compare(target, root.Inner)
+	if c < 0 {
+		return count(root.Prev, target, compare)
+	}
+	if c > 0 {
+		return count(root.Next, target, compare)
+	}
+	return root.Count + count(root.Prev, target, compare) + count(root.Next, target, compare)
+}
+
+// Count returns how many times element is in the tree.
+func (bt *BinaryTree[T]) Count(element T) int {
+	i := 0
+	bt.withLock(func() {
+		i = count(bt.head, element, bt.comparator())
+	})
+	return i
+}
 
+// Rank returns the number of values strictly less than element.
+func (bt *BinaryTree[T]) Rank(element T) int {
+	r := 0
+	bt.withLock(func() {
+		r = rank(bt.head, element, bt.comparator())
+	})
+	return r
+}
+
+// Select returns the k-th smallest value, k is zero based.
+func (bt *BinaryTree[T]) Select(k int) (T, bool) {
+	var (
+		rs T
+		b  bool
+	)
+	bt.withLock(func() {
+		rs, b = selectAt(bt.head, k).Get()
+	})
 	return rs, b
 }
 
+// Size returns the number of values in the tree.
+func (bt *BinaryTree[T]) Size() int {
+	i := 0
+	bt.withLock(func() {
+		i = size(bt.head)
+	})
+	return i
+}
+
+// Clear removes all the values from the tree.
+func (bt *BinaryTree[T]) Clear() {
+	bt.withLock(func() {
+		bt.head = nil
+	})
+}
+
 // visualizeNode helps in the recursive visualization of the binary tree.
-func (bt *BinaryTree[T]) visualizeNode(node *node.Node[T], prefix string, isTail bool, sb *strings.Builder) {
+func (bt *BinaryTree[T]) visualizeNode(node *node.TreeNode[T], prefix string, isTail bool, sb *strings.Builder) {
 	if node == nil {
 		return
 	}
@@ -374,7 +895,11 @@ func (bt *BinaryTree[T]) visualizeNode(node *node.Node[T], prefix string, isTail
 	} else {
 		sb.WriteString("├── ")
 	}
-	sb.WriteString(fmt.Sprintf("%v\n", node.Inner))
+	sb.WriteString(fmt.Sprintf("%v", node.Inner))
+	if node.Count > 1 {
+		sb.WriteString(fmt.Sprintf(" (x%d)", node.Count))
+	}
+	sb.WriteString("\n")
 
 	// Prepare the prefix for child nodes
 	childPrefix := prefix
@@ -398,19 +923,29 @@ func (bt *BinaryTree[T]) visualizeNode(node *node.Node[T], prefix string, isTail
 }
 
 func (bt *BinaryTree[T]) Items() []T {
-	f := bt.resolveTravelsalFunc(bt.TraversalOrder())
-
 	var items []T
-	for _, v := range f {
+	for v := range bt.Values() {
 		items = append(items, v)
 	}
 
 	return items
 }
 
-type BinaryTreeJson[T cmp.Ordered] struct {
-	Data           []T            `json:"data"`
-	TraversalOrder TraversalOrder `json:"traversal_order"`
+// All iterates the values with their indexes in the traversal order of the tree.
+func (bt *BinaryTree[T]) All() iter.Seq2[int, T] {
+	return bt.resolveTravelsalFunc(bt.TraversalOrder())
+}
+
+// Values iterates the values in the traversal order of the tree.
+func (bt *BinaryTree[T]) Values() iter.Seq[T] {
+	return seqs.Values(bt.All())
+}
+
+type BinaryTreeJson[T any] struct {
+	Data           []T             `json:"data"`
+	TraversalOrder TraversalOrder  `json:"traversal_order"`
+	SelfBalancing  bool            `json:"self_balancing,omitempty"`
+	Duplicates     DuplicatePolicy `json:"duplicates,omitempty"`
 }
 
 func (bt *BinaryTree[T]) TraversalOrder() TraversalOrder {
@@ -421,6 +956,14 @@ func (bt *BinaryTree[T]) TraversalOrder() TraversalOrder {
 	return to
 }
 
+func (bt *BinaryTree[T]) DuplicatePolicy() DuplicatePolicy {
+	dp := bt.duplicates
+	if bt.duplicates == "" {
+		dp = DefaultDuplicates
+	}
+	return dp
+}
+
 func (bt *BinaryTree[T]) UnmarshalJSON(data []byte) error {
 	var aux BinaryTreeJson[T]
 
@@ -428,7 +971,17 @@ func (bt *BinaryTree[T]) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
+	if bt.compare == nil {
+		if _, ok := ordering.Natural[T](); !ok {
+			return fmt.Errorf("binary tree of %T has no comparator, create it with NewBinaryTreeFunc", *new(T))
+		}
+	}
+
 	bt.traversalOrder = aux.TraversalOrder
+	bt.selfBalancing = bt.selfBalancing || aux.SelfBalancing
+	if aux.Duplicates != "" {
+		bt.duplicates = aux.Duplicates
+	}
 	bt.Insert(aux.Data...)
 
 	return nil
@@ -440,6 +993,8 @@ func (bt *BinaryTree[T]) MarshalJSON() ([]byte, error) {
 	aux := BinaryTreeJson[T]{
 		Data:           items,
 		TraversalOrder: bt.TraversalOrder(),
+		SelfBalancing:  bt.selfBalancing,
+		Duplicates:     bt.duplicates,
 	}
 	return json.Marshal(aux)
 }
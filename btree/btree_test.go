@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"slices"
 	"testing"
 
 	"github.com/johannessarpola/gollections/comps"
+	"github.com/johannessarpola/gollections/internal/node"
 )
 
 func TestBasic(t *testing.T) {
@@ -465,3 +467,530 @@ func TestBinaryTree_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestBinaryTree_Delete(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     []int
+		delete    int
+		expected  []int
+		wantFound bool
+	}{
+		{name: "delete-leaf", input: []int{5, 3, 7, 2, 4, 6, 8}, delete: 2, expected: []int{5, 3, 4, 7, 6, 8}, wantFound: true},
+		{name: "delete-single-child", input: []int{5, 3, 7, 2, 6, 8}, delete: 3, expected: []int{5, 2, 7, 6, 8}, wantFound: true},
+		{name: "delete-two-children", input: []int{5, 3, 7, 2, 4, 6, 8}, delete: 3, expected: []int{5, 4, 2, 7, 6, 8}, wantFound: true},
+		{name: "delete-root", input: []int{5, 3, 7, 2, 4, 6, 8}, delete: 5, expected: []int{6, 3, 2, 4, 7, 8}, wantFound: true},
+		{name: "delete-successor-with-child", input: []int{5, 3, 8, 6, 7}, delete: 5, expected: []int{6, 3, 8, 7}, wantFound: true},
+		{name: "delete-only", input: []int{1}, delete: 1, expected: nil, wantFound: true},
+		{name: "delete-duplicate", input: []int{1, 1, 1}, delete: 1, expected: []int{1, 1}, wantFound: true},
+		{name: "delete-missing", input: []int{5, 3, 7}, delete: 99, expected: []int{5, 3, 7}, wantFound: false},
+		{name: "delete-empty", input: []int{}, delete: 1, expected: nil, wantFound: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bt := NewBinaryTree[int]()
+			bt.Insert(test.input...)
+
+			if b := bt.Delete(test.delete); b != test.wantFound {
+				t.Errorf("Delete(%v) = %v, want %v", test.delete, b, test.wantFound)
+			}
+
+			if rs := bt.Items(); !reflect.DeepEqual(rs, test.expected) {
+				t.Errorf("got %v, want %v", rs, test.expected)
+			}
+
+			if bt.Size() != len(test.expected) {
+				t.Errorf("got size %d, want %d", bt.Size(), len(test.expected))
+			}
+
+			if bt.Height() != treeHeight(bt.head) {
+				t.Errorf("cached height %d does not match actual %d", bt.Height(), treeHeight(bt.head))
+			}
+		})
+	}
+}
+
+func TestBinaryTree_DeleteSelfBalancing(t *testing.T) {
+	bt := NewBinaryTree[int](WithSelfBalancing(), WithTraversalOrder(InOrder))
+	for i := 0; i < 512; i++ {
+		bt.Insert(i)
+	}
+
+	for i := 0; i < 512; i += 2 {
+		if !bt.Delete(i) {
+			t.Fatalf("expected %d to be deleted", i)
+		}
+		if _, ok := isAVL(bt.head); !ok {
+			t.Fatalf("tree is not balanced after deleting %d", i)
+		}
+	}
+
+	items := bt.Items()
+	if len(items) != 256 {
+		t.Errorf("expected 256 items, got %d", len(items))
+	}
+	for _, v := range items {
+		if v%2 == 0 {
+			t.Errorf("expected %d to be deleted", v)
+		}
+	}
+}
+
+func TestBinaryTree_DeleteMinMax(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []int
+		wantMin []int
+		wantMax []int
+	}{
+		{name: "deleteMinMax-1", input: []int{99, 77, 33, 101, 90}, wantMin: []int{33, 77, 90, 99, 101}, wantMax: []int{101, 99, 90, 77, 33}},
+		{name: "deleteMinMax-2", input: []int{1, 2, 3}, wantMin: []int{1, 2, 3}, wantMax: []int{3, 2, 1}},
+		{name: "deleteMinMax-3", input: []int{3, 2, 1}, wantMin: []int{1, 2, 3}, wantMax: []int{3, 2, 1}},
+		{name: "deleteMinMax-4", input: []int{}, wantMin: nil, wantMax: nil},
+	}
+	for _, test := range tests {
+		for _, balanced := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s-%v", test.name, balanced), func(t *testing.T) {
+				var opts []TreeOpt
+				if balanced {
+					opts = append(opts, WithSelfBalancing())
+				}
+
+				bt := NewBinaryTree[int](opts...)
+				bt.Insert(test.input...)
+				var mins []int
+				for v, ok := bt.DeleteMin(); ok; v, ok = bt.DeleteMin() {
+					mins = append(mins, v)
+				}
+				if !reflect.DeepEqual(mins, test.wantMin) {
+					t.Errorf("DeleteMin() got %v, want %v", mins, test.wantMin)
+				}
+
+				bt.Insert(test.input...)
+				var maxs []int
+				for v, ok := bt.DeleteMax(); ok; v, ok = bt.DeleteMax() {
+					maxs = append(maxs, v)
+				}
+				if !reflect.DeepEqual(maxs, test.wantMax) {
+					t.Errorf("DeleteMax() got %v, want %v", maxs, test.wantMax)
+				}
+			})
+		}
+	}
+}
+
+func TestBinaryTree_Navigation(t *testing.T) {
+	tests := []struct {
+		name                          string
+		target                        int
+		floor, ceiling, lower, higher int
+		floorOk, ceilingOk            bool
+		lowerOk, higherOk             bool
+	}{
+		{name: "nav-exact", target: 40, floor: 40, ceiling: 40, lower: 30, higher: 50, floorOk: true, ceilingOk: true, lowerOk: true, higherOk: true},
+		{name: "nav-between", target: 45, floor: 40, ceiling: 50, lower: 40, higher: 50, floorOk: true, ceilingOk: true, lowerOk: true, higherOk: true},
+		{name: "nav-min", target: 20, floor: 20, ceiling: 20, lower: 0, higher: 30, floorOk: true, ceilingOk: true, lowerOk: false, higherOk: true},
+		{name: "nav-max", target: 80, floor: 80, ceiling: 80, lower: 70, higher: 0, floorOk: true, ceilingOk: true, lowerOk: true, higherOk: false},
+		{name: "nav-below", target: 1, floor: 0, ceiling: 20, lower: 0, higher: 20, floorOk: false, ceilingOk: true, lowerOk: false, higherOk: true},
+		{name: "nav-above", target: 99, floor: 80, ceiling: 0, lower: 80, higher: 0, floorOk: true, ceilingOk: false, lowerOk: true, higherOk: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bt := NewBinaryTree[int]()
+			bt.Insert(50, 30, 20, 40, 70, 60, 80)
+
+			if v, b := bt.Floor(test.target); v != test.floor || b != test.floorOk {
+				t.Errorf("Floor(%d) = %v (%v), want %v (%v)", test.target, v, b, test.floor, test.floorOk)
+			}
+			if v, b := bt.Ceiling(test.target); v != test.ceiling || b != test.ceilingOk {
+				t.Errorf("Ceiling(%d) = %v (%v), want %v (%v)", test.target, v, b, test.ceiling, test.ceilingOk)
+			}
+			if v, b := bt.Lower(test.target); v != test.lower || b != test.lowerOk {
+				t.Errorf("Lower(%d) = %v (%v), want %v (%v)", test.target, v, b, test.lower, test.lowerOk)
+			}
+			if v, b := bt.Higher(test.target); v != test.higher || b != test.higherOk {
+				t.Errorf("Higher(%d) = %v (%v), want %v (%v)", test.target, v, b, test.higher, test.higherOk)
+			}
+		})
+	}
+}
+
+func TestBinaryTree_Range(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []int
+		lo, hi int
+		want   []int
+	}{
+		{name: "range-1", input: []int{50, 30, 20, 40, 70, 60, 80}, lo: 30, hi: 70, want: []int{30, 40, 50, 60}},
+		{name: "range-2", input: []int{50, 30, 20, 40, 70, 60, 80}, lo: 0, hi: 100, want: []int{20, 30, 40, 50, 60, 70, 80}},
+		{name: "range-3", input: []int{50, 30, 20, 40, 70, 60, 80}, lo: 41, hi: 49, want: nil},
+		{name: "range-4", input: []int{1, 2, 3, 4, 5}, lo: 2, hi: 4, want: []int{2, 3}},
+		{name: "range-5", input: []int{}, lo: 0, hi: 10, want: nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bt := NewBinaryTree[int](WithSelfBalancing())
+			bt.Insert(test.input...)
+
+			var rs []int
+			for v := range bt.Range(test.lo, test.hi) {
+				rs = append(rs, v)
+			}
+			if !reflect.DeepEqual(rs, test.want) {
+				t.Errorf("Range(%d, %d) got %v, want %v", test.lo, test.hi, rs, test.want)
+			}
+		})
+	}
+}
+
+func TestBinaryTree_RankSelect(t *testing.T) {
+	input := []int{50, 30, 20, 40, 70, 60, 80}
+	sorted := []int{20, 30, 40, 50, 60, 70, 80}
+
+	for _, balanced := range []bool{false, true} {
+		t.Run(fmt.Sprintf("rankSelect-%v", balanced), func(t *testing.T) {
+			var opts []TreeOpt
+			if balanced {
+				opts = append(opts, WithSelfBalancing())
+			}
+			bt := NewBinaryTree[int](opts...)
+			bt.Insert(input...)
+
+			for i, v := range sorted {
+				if r := bt.Rank(v); r != i {
+					t.Errorf("Rank(%d) = %d, want %d", v, r, i)
+				}
+				if s, b := bt.Select(i); s != v || !b {
+					t.Errorf("Select(%d) = %d (%v), want %d", i, s, b, v)
+				}
+			}
+
+			if r := bt.Rank(45); r != 3 {
+				t.Errorf("Rank(45) = %d, want 3", r)
+			}
+			if r := bt.Rank(100); r != len(sorted) {
+				t.Errorf("Rank(100) = %d, want %d", r, len(sorted))
+			}
+			if _, b := bt.Select(len(sorted)); b {
+				t.Errorf("Select out of range should return false")
+			}
+			if _, b := bt.Select(-1); b {
+				t.Errorf("Select out of range should return false")
+			}
+
+			bt.Delete(50)
+			if s, _ := bt.Select(3); s != 60 {
+				t.Errorf("Select(3) after delete = %d, want 60", s)
+			}
+		})
+	}
+}
+
+// treeHeight computes the height of the tree without using the cached heights.
+func treeHeight[T any](root *node.TreeNode[T]) int {
+	if root == nil {
+		return 0
+	}
+	return max(treeHeight(root.Prev), treeHeight(root.Next)) + 1
+}
+
+type job struct {
+	Name     string `json:"name"`
+	Deadline int    `json:"deadline"`
+}
+
+func byDeadline(a, b job) int {
+	return cmp.Compare(a.Deadline, b.Deadline)
+}
+
+func TestBinaryTreeFunc(t *testing.T) {
+	jobs := []job{
+		{Name: "deploy", Deadline: 30},
+		{Name: "build", Deadline: 10},
+		{Name: "test", Deadline: 20},
+		{Name: "release", Deadline: 40},
+	}
+
+	for _, balanced := range []bool{false, true} {
+		t.Run(fmt.Sprintf("func-%v", balanced), func(t *testing.T) {
+			opts := []TreeOpt{WithTraversalOrder(InOrder)}
+			if balanced {
+				opts = append(opts, WithSelfBalancing())
+			}
+
+			bt := NewBinaryTreeFunc(byDeadline, opts...)
+			bt.Insert(jobs...)
+
+			var names []string
+			for _, j := range bt.InOrder {
+				names = append(names, j.Name)
+			}
+			if want := []string{"build", "test", "deploy", "release"}; !reflect.DeepEqual(names, want) {
+				t.Errorf("got %v, want %v", names, want)
+			}
+
+			if j, ok := bt.Search(job{Deadline: 20}); !ok || j.Name != "test" {
+				t.Errorf("Search() got %v (%v), want %v", j, ok, "test")
+			}
+
+			if j, ok := bt.Ceiling(job{Deadline: 25}); !ok || j.Name != "deploy" {
+				t.Errorf("Ceiling() got %v (%v), want %v", j, ok, "deploy")
+			}
+
+			if j, ok := bt.FindMin(); !ok || j.Name != "build" {
+				t.Errorf("FindMin() got %v (%v), want %v", j, ok, "build")
+			}
+
+			if !bt.Delete(job{Deadline: 10}) {
+				t.Errorf("expected job to be deleted")
+			}
+
+			d, err := json.Marshal(&bt)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			nbt := NewBinaryTreeFunc(byDeadline)
+			if err := json.Unmarshal(d, &nbt); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(nbt.Items(), bt.Items()) {
+				t.Errorf("UnmarshalJSON() got %v, want %v", nbt.Items(), bt.Items())
+			}
+		})
+	}
+}
+
+func TestBinaryTree_Duplicates(t *testing.T) {
+	input := []int{5, 3, 5, 7, 3, 5}
+	tests := []struct {
+		name      string
+		policy    DuplicatePolicy
+		wantItems []int
+		wantCount int // count of 5
+		wantSize  int
+	}{
+		{name: "duplicates-default", policy: "", wantItems: []int{3, 3, 5, 5, 5, 7}, wantCount: 3, wantSize: 6},
+		{name: "duplicates-allow", policy: AllowDuplicates, wantItems: []int{3, 3, 5, 5, 5, 7}, wantCount: 3, wantSize: 6},
+		{name: "duplicates-reject", policy: RejectDuplicates, wantItems: []int{3, 5, 7}, wantCount: 1, wantSize: 3},
+		{name: "duplicates-count", policy: CountDuplicates, wantItems: []int{3, 3, 5, 5, 5, 7}, wantCount: 3, wantSize: 6},
+		{name: "duplicates-replace", policy: ReplaceDuplicates, wantItems: []int{3, 5, 7}, wantCount: 1, wantSize: 3},
+	}
+	for _, test := range tests {
+		for _, balanced := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s-%v", test.name, balanced), func(t *testing.T) {
+				opts := []TreeOpt{WithDuplicates(test.policy), WithTraversalOrder(InOrder)}
+				if balanced {
+					opts = append(opts, WithSelfBalancing())
+				}
+				bt := NewBinaryTree[int](opts...)
+				bt.Insert(input...)
+
+				if rs := bt.Items(); !reflect.DeepEqual(rs, test.wantItems) {
+					t.Errorf("got %v, want %v", rs, test.wantItems)
+				}
+				if c := bt.Count(5); c != test.wantCount {
+					t.Errorf("Count(5) = %d, want %d", c, test.wantCount)
+				}
+				if c := bt.Count(99); c != 0 {
+					t.Errorf("Count(99) = %d, want 0", c)
+				}
+				if bt.Size() != test.wantSize {
+					t.Errorf("Size() = %d, want %d", bt.Size(), test.wantSize)
+				}
+
+				for i, v := range test.wantItems {
+					if s, _ := bt.Select(i); s != v {
+						t.Errorf("Select(%d) = %d, want %d", i, s, v)
+					}
+				}
+				if r := bt.Rank(7); r != test.wantSize-1 {
+					t.Errorf("Rank(7) = %d, want %d", r, test.wantSize-1)
+				}
+
+				bt.Delete(5)
+				if c := bt.Count(5); c != test.wantCount-1 {
+					t.Errorf("Count(5) after delete = %d, want %d", c, test.wantCount-1)
+				}
+				if bt.Size() != test.wantSize-1 {
+					t.Errorf("Size() after delete = %d, want %d", bt.Size(), test.wantSize-1)
+				}
+			})
+		}
+	}
+}
+
+func TestBinaryTree_CountDuplicates(t *testing.T) {
+	bt := NewBinaryTree[int](WithDuplicates(CountDuplicates))
+	bt.Insert(2, 1, 1, 3, 3, 3)
+
+	// duplicates of the count policy live in a single node
+	if bt.Height() != 2 {
+		t.Errorf("expected height 2, got %d", bt.Height())
+	}
+
+	var mins []int
+	for v, ok := bt.DeleteMin(); ok; v, ok = bt.DeleteMin() {
+		mins = append(mins, v)
+		if v == 2 {
+			break
+		}
+	}
+	if want := []int{1, 1, 2}; !reflect.DeepEqual(mins, want) {
+		t.Errorf("DeleteMin() got %v, want %v", mins, want)
+	}
+	if v, _ := bt.DeleteMax(); v != 3 || bt.Count(3) != 2 {
+		t.Errorf("DeleteMax() should remove a single occurrence, got %v with count %d", v, bt.Count(3))
+	}
+
+	bt.Insert(0, 0, 10)
+	bt.Balance()
+	var rs []int
+	for _, v := range bt.InOrder {
+		rs = append(rs, v)
+	}
+	if want := []int{0, 0, 3, 3, 10}; !reflect.DeepEqual(rs, want) {
+		t.Errorf("got %v, want %v", rs, want)
+	}
+	if c := bt.Count(0); c != 2 {
+		t.Errorf("Balance() should keep multiplicities, got count %d", c)
+	}
+
+	d, err := json.Marshal(&bt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nbt := NewBinaryTree[int]()
+	if err := json.Unmarshal(d, &nbt); err != nil {
+		t.Fatal(err)
+	}
+	if nbt.DuplicatePolicy() != CountDuplicates || nbt.Count(3) != 2 || nbt.Height() != bt.Height() {
+		t.Errorf("UnmarshalJSON() should restore the count policy, got %v", nbt.String())
+	}
+}
+
+func TestBinaryTree_ReplaceDuplicates(t *testing.T) {
+	bt := NewBinaryTreeFunc(byDeadline, WithDuplicates(ReplaceDuplicates))
+	bt.Insert(job{Name: "build", Deadline: 10}, job{Name: "rebuild", Deadline: 10})
+
+	if j, _ := bt.Search(job{Deadline: 10}); j.Name != "rebuild" || bt.Size() != 1 {
+		t.Errorf("expected the job to be replaced, got %v", bt.Items())
+	}
+}
+
+func TestBinaryTree_Iterators(t *testing.T) {
+	bt := FromSeq(slices.Values([]int{5, 3, 7, 2, 4}), WithTraversalOrder(InOrder))
+
+	if got := slices.Collect(bt.Values()); !reflect.DeepEqual(got, []int{2, 3, 4, 5, 7}) {
+		t.Errorf("Values() got %v, want %v", got, []int{2, 3, 4, 5, 7})
+	}
+
+	want := []int{2, 3, 4, 5, 7}
+	for i, v := range bt.All() {
+		if want[i] != v {
+			t.Errorf("All() got %d at index %d, want %d", v, i, want[i])
+		}
+	}
+
+	pre := FromSeq(slices.Values([]int{5, 3, 7, 2, 4}))
+	if got := slices.Collect(pre.Values()); !reflect.DeepEqual(got, []int{5, 3, 2, 4, 7}) {
+		t.Errorf("Values() got %v, want %v", got, []int{5, 3, 2, 4, 7})
+	}
+
+	jobs := FromSeqFunc(slices.Values([]job{{Name: "b", Deadline: 2}, {Name: "a", Deadline: 1}}), byDeadline, WithSelfBalancing())
+	if j, _ := jobs.FindMin(); j.Name != "a" {
+		t.Errorf("FindMin() got %v, want %v", j.Name, "a")
+	}
+}
+
+func TestBinaryTree_ModifyWhileIterating(t *testing.T) {
+	bt := NewBinaryTree[int](WithSelfBalancing(), WithTraversalOrder(InOrder))
+	bt.Insert(1, 2, 3, 4)
+
+	for _, v := range bt.InOrder {
+		if _, ok := bt.Search(v); !ok || bt.Size() != 4 {
+			t.Errorf("expected %d to be found", v)
+		}
+		bt.Delete(v)
+		bt.Insert(v * 10)
+	}
+
+	if got := slices.Collect(bt.Values()); !reflect.DeepEqual(got, []int{10, 20, 30, 40}) {
+		t.Errorf("got %v, want %v", got, []int{10, 20, 30, 40})
+	}
+
+	for v := range bt.Range(0, 100) {
+		bt.Delete(v)
+	}
+
+	// Balance used to range over the tree itself
+	bt.Insert(1, 2, 3)
+	bt.Balance()
+
+	if got := bt.Items(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("got %v, want %v", got, []int{1, 2, 3})
+	}
+}
+
+func TestBinaryTree_Clear(t *testing.T) {
+	bt := FromSeq(slices.Values([]int{2, 1, 3}), WithSelfBalancing())
+	bt.Clear()
+
+	if bt.Size() != 0 || bt.Height() != 0 {
+		t.Errorf("expected tree to be empty after Clear, got %v", bt.Items())
+	}
+
+	bt.Insert(4)
+	if got := bt.Items(); !reflect.DeepEqual(got, []int{4}) {
+		t.Errorf("got %v, want %v", got, []int{4})
+	}
+}
+
+type celsius float64
+
+func TestBinaryTree_ZeroValueUnordered(t *testing.T) {
+	var bt BinaryTree[struct{}]
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected panic on first insert of an unordered type")
+		}
+	}()
+	bt.Insert(struct{}{})
+}
+
+func TestBinaryTree_ZeroValueUnorderedUnmarshal(t *testing.T) {
+	type job struct{ D int }
+	type contained struct {
+		T BinaryTree[job]
+	}
+
+	var c contained
+	err := json.Unmarshal([]byte(`{"T":{"data":[{"D":1}],"traversal_order":"inOrder"}}`), &c)
+	if err == nil {
+		t.Errorf("expected error for a tree of an unordered type without comparator")
+	}
+
+	bt := NewBinaryTreeFunc(func(a, b job) int { return cmp.Compare(a.D, b.D) })
+	if err := json.Unmarshal([]byte(`{"data":[{"D":2},{"D":1}],"traversal_order":"inOrder"}`), &bt); err != nil {
+		t.Fatal(err)
+	}
+	if want := []job{{1}, {2}}; !reflect.DeepEqual(bt.Items(), want) {
+		t.Errorf("got %v, want %v", bt.Items(), want)
+	}
+}
+
+func TestBinaryTree_ZeroValue(t *testing.T) {
+	type contained struct {
+		Tree BinaryTree[celsius] `json:"tree"`
+	}
+
+	var c contained
+	if err := json.Unmarshal([]byte(`{"tree":{"data":[3,1,2],"traversal_order":"inOrder"}}`), &c); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []celsius{1, 2, 3}; !reflect.DeepEqual(c.Tree.Items(), want) {
+		t.Errorf("got %v, want %v", c.Tree.Items(), want)
+	}
+}
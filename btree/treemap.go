@@ -0,0 +1,231 @@
+package btree
+
+import (
+	"cmp"
+	"encoding/json"
+	"iter"
+	"sync"
+
+	"github.com/johannessarpola/gollections/internal/node"
+	"github.com/johannessarpola/gollections/internal/seqs"
+)
+
+// Entry is a key value pair stored in the TreeMap.
+type Entry[K cmp.Ordered, V any] struct {
+	Key   K `json:"key"`
+	Value V `json:"value"`
+}
+
+// pair returns the key and value of the entry.
+func (e Entry[K, V]) pair() (K, V) {
+	return e.Key, e.Value
+}
+
+// TreeMap is a map which keeps its keys sorted in a binary search tree.
+type TreeMap[K cmp.Ordered, V any] struct {
+	head           *node.TreeNode[Entry[K, V]]
+	mu             sync.Mutex
+	traversalOrder TraversalOrder // used for json
+	selfBalancing  bool           // keeps the tree AVL balanced on insert
+}
+
+// NewTreeMap creates an empty map. WithDuplicates is ignored as the map always replaces
+// the value of an existing key.
+func NewTreeMap[K cmp.Ordered, V any](opts ...TreeOpt) TreeMap[K, V] {
+	args := argHandler(opts)
+	return TreeMap[K, V]{
+		traversalOrder: args.Order,
+		selfBalancing:  args.SelfBalancing,
+	}
+}
+
+// FromSeq2 creates a new map with the key value pairs of seq, later values replace earlier ones for equal keys.
+// WithDuplicates is ignored as for NewTreeMap.
+func FromSeq2[K cmp.Ordered, V any](seq iter.Seq2[K, V], opts ...TreeOpt) TreeMap[K, V] {
+	args := argHandler(opts)
+
+	var head *node.TreeNode[Entry[K, V]]
+	for k, v := range seq {
+		head = insert(head, Entry[K, V]{Key: k, Value: v}, compareKeys, args.SelfBalancing, ReplaceDuplicates)
+	}
+
+	return TreeMap[K, V]{
+		head:           head,
+		traversalOrder: args.Order,
+		selfBalancing:  args.SelfBalancing,
+	}
+}
+
+func (tm *TreeMap[K, V]) withLock(f func()) {
+	defer tm.mu.Unlock()
+	tm.mu.Lock()
+	f()
+}
+
+// compareKeys orders entries by their key only.
+func compareKeys[K cmp.Ordered, V any](a, b Entry[K, V]) int {
+	return cmp.Compare(a.Key, b.Key)
+}
+
+// Put sets the value for key, replacing the existing value if the key is already present.
+func (tm *TreeMap[K, V]) Put(key K, value V) {
+	e := Entry[K, V]{Key: key, Value: value}
+	tm.withLock(func() {
+		tm.head = insert(tm.head, e, compareKeys, tm.selfBalancing, ReplaceDuplicates)
+	})
+}
+
+// Get returns the value for key, bool is false if the key is not present.
+func (tm *TreeMap[K, V]) Get(key K) (V, bool) {
+	var (
+		v V
+		b bool
+	)
+	tm.withLock(func() {
+		if n := lookup(tm.head, Entry[K, V]{Key: key}, compareKeys); n != nil {
+			v, b = n.Inner.Value, true
+		}
+	})
+	return v, b
+}
+
+// ContainsKey returns true if the key is present.
+func (tm *TreeMap[K, V]) ContainsKey(key K) bool {
+	_, b := tm.Get(key)
+	return b
+}
+
+// Delete removes the key and its value, returns false if the key was not present.
+func (tm *TreeMap[K, V]) Delete(key K) bool {
+	b := false
+	tm.withLock(func() {
+		tm.head, b = remove(tm.head, Entry[K, V]{Key: key}, compareKeys, tm.selfBalancing)
+	})
+	return b
+}
+
+// Floor returns the entry with the greatest key less than or equal to key.
+func (tm *TreeMap[K, V]) Floor(key K) (K, V, bool) {
+	var (
+		k K
+		v V
+		b bool
+	)
+	tm.withLock(func() {
+		k, v, b = unpack(floor(tm.head, Entry[K, V]{Key: key}, compareKeys))
+	})
+	return k, v, b
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to key.
+func (tm *TreeMap[K, V]) Ceiling(key K) (K, V, bool) {
+	var (
+		k K
+		v V
+		b bool
+	)
+	tm.withLock(func() {
+		k, v, b = unpack(ceiling(tm.head, Entry[K, V]{Key: key}, compareKeys))
+	})
+	return k, v, b
+}
+
+// unpack returns the key and value of the entry node, bool is false for nil.
+func unpack[K cmp.Ordered, V any](n *node.TreeNode[Entry[K, V]]) (K, V, bool) {
+	e, b := n.Get()
+	return e.Key, e.Value, b
+}
+
+// Size returns the number of keys in the map.
+func (tm *TreeMap[K, V]) Size() int {
+	i := 0
+	tm.withLock(func() {
+		i = size(tm.head)
+	})
+	return i
+}
+
+// snapshot copies the entries in the given order while holding the lock.
+func (tm *TreeMap[K, V]) snapshot(order TraversalOrder) []Entry[K, V] {
+	var entries []Entry[K, V]
+	tm.withLock(func() {
+		traverse(tm.head, order, func(_ int, e Entry[K, V]) bool {
+			entries = append(entries, e)
+			return true
+		})
+	})
+	return entries
+}
+
+// All iterates the entries in ascending key order.
+func (tm *TreeMap[K, V]) All() iter.Seq2[K, V] {
+	return seqs.SnapshotPairs(func() []Entry[K, V] { return tm.snapshot(InOrder) }, Entry[K, V].pair)
+}
+
+// Keys iterates the keys in ascending order.
+func (tm *TreeMap[K, V]) Keys() iter.Seq[K] {
+	return seqs.Keys(tm.All())
+}
+
+// Values iterates the values in ascending order of their keys.
+func (tm *TreeMap[K, V]) Values() iter.Seq[V] {
+	return seqs.Values(tm.All())
+}
+
+// Range iterates in ascending key order the entries with keys in the range [lo, hi).
+func (tm *TreeMap[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return seqs.SnapshotPairs(func() []Entry[K, V] {
+		var entries []Entry[K, V]
+		tm.withLock(func() {
+			between(tm.head, Entry[K, V]{Key: lo}, Entry[K, V]{Key: hi}, compareKeys, func(e Entry[K, V]) bool {
+				entries = append(entries, e)
+				return true
+			})
+		})
+		return entries
+	}, Entry[K, V].pair)
+}
+
+func (tm *TreeMap[K, V]) TraversalOrder() TraversalOrder {
+	to := tm.traversalOrder
+	if tm.traversalOrder == "" {
+		to = DefaultTraversal
+	}
+	return to
+}
+
+// Entries returns the entries in the traversal order of the map.
+func (tm *TreeMap[K, V]) Entries() []Entry[K, V] {
+	return tm.snapshot(tm.TraversalOrder())
+}
+
+type TreeMapJson[K cmp.Ordered, V any] struct {
+	Data           []Entry[K, V]  `json:"data"`
+	TraversalOrder TraversalOrder `json:"traversal_order"`
+	SelfBalancing  bool           `json:"self_balancing,omitempty"`
+}
+
+func (tm *TreeMap[K, V]) UnmarshalJSON(data []byte) error {
+	var aux TreeMapJson[K, V]
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	tm.traversalOrder = aux.TraversalOrder
+	tm.selfBalancing = tm.selfBalancing || aux.SelfBalancing
+	for _, e := range aux.Data {
+		tm.Put(e.Key, e.Value)
+	}
+
+	return nil
+}
+
+func (tm *TreeMap[K, V]) MarshalJSON() ([]byte, error) {
+	aux := TreeMapJson[K, V]{
+		Data:           tm.Entries(),
+		TraversalOrder: tm.TraversalOrder(),
+		SelfBalancing:  tm.selfBalancing,
+	}
+	return json.Marshal(aux)
+}
@@ -0,0 +1,203 @@
+package btree
+
+import (
+	"encoding/json"
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestTreeMap_PutGetDelete(t *testing.T) {
+	tm := NewTreeMap[string, int](WithSelfBalancing())
+
+	tm.Put("b", 2)
+	tm.Put("a", 1)
+	tm.Put("c", 3)
+	tm.Put("b", 22)
+
+	if tm.Size() != 3 {
+		t.Errorf("expected size 3, got %d", tm.Size())
+	}
+
+	if v, ok := tm.Get("b"); v != 22 || !ok {
+		t.Errorf("expected value for b to be replaced with 22, got %v (%v)", v, ok)
+	}
+
+	if _, ok := tm.Get("x"); ok {
+		t.Errorf("expected x to not be present")
+	}
+
+	if !tm.Delete("a") {
+		t.Errorf("expected a to be deleted")
+	}
+	if tm.Delete("a") {
+		t.Errorf("expected a to be already deleted")
+	}
+	if tm.ContainsKey("a") {
+		t.Errorf("expected a to not be present after delete")
+	}
+	if tm.Size() != 2 {
+		t.Errorf("expected size 2, got %d", tm.Size())
+	}
+}
+
+func TestTreeMap_FloorCeiling(t *testing.T) {
+	tm := NewTreeMap[int, string]()
+	for _, k := range []int{10, 20, 30, 40} {
+		tm.Put(k, "v")
+	}
+
+	tests := []struct {
+		name        string
+		key         int
+		wantFloor   int
+		floorOk     bool
+		wantCeiling int
+		ceilingOk   bool
+	}{
+		{name: "exact", key: 20, wantFloor: 20, floorOk: true, wantCeiling: 20, ceilingOk: true},
+		{name: "between", key: 25, wantFloor: 20, floorOk: true, wantCeiling: 30, ceilingOk: true},
+		{name: "below", key: 5, wantFloor: 0, floorOk: false, wantCeiling: 10, ceilingOk: true},
+		{name: "above", key: 45, wantFloor: 40, floorOk: true, wantCeiling: 0, ceilingOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if k, _, ok := tm.Floor(tt.key); k != tt.wantFloor || ok != tt.floorOk {
+				t.Errorf("Floor(%d) = %d (%v), want %d (%v)", tt.key, k, ok, tt.wantFloor, tt.floorOk)
+			}
+			if k, _, ok := tm.Ceiling(tt.key); k != tt.wantCeiling || ok != tt.ceilingOk {
+				t.Errorf("Ceiling(%d) = %d (%v), want %d (%v)", tt.key, k, ok, tt.wantCeiling, tt.ceilingOk)
+			}
+		})
+	}
+}
+
+func TestTreeMap_Iteration(t *testing.T) {
+	tm := NewTreeMap[int, string]()
+	for _, k := range []int{5, 3, 7, 2, 4, 6, 8} {
+		tm.Put(k, string(rune('a'+k)))
+	}
+
+	var keys []int
+	for k, v := range tm.All() {
+		if v != string(rune('a'+k)) {
+			t.Errorf("unexpected value %v for key %d", v, k)
+		}
+		keys = append(keys, k)
+	}
+	if want := []int{2, 3, 4, 5, 6, 7, 8}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("All() got %v, want %v", keys, want)
+	}
+
+	tests := []struct {
+		name   string
+		lo, hi int
+		want   []int
+	}{
+		{name: "range-1", lo: 3, hi: 6, want: []int{3, 4, 5}},
+		{name: "range-2", lo: 0, hi: 100, want: []int{2, 3, 4, 5, 6, 7, 8}},
+		{name: "range-3", lo: 6, hi: 6, want: nil},
+		{name: "range-4", lo: 9, hi: 20, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			for k := range tm.Range(tt.lo, tt.hi) {
+				got = append(got, k)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Range(%d, %d) got %v, want %v", tt.lo, tt.hi, got, tt.want)
+			}
+		})
+	}
+
+	// breaking out of the loop must stop the traversal
+	var first []int
+	for k := range tm.All() {
+		first = append(first, k)
+		if len(first) == 2 {
+			break
+		}
+	}
+	if want := []int{2, 3}; !reflect.DeepEqual(first, want) {
+		t.Errorf("got %v, want %v", first, want)
+	}
+}
+
+func TestTreeMap_JSON(t *testing.T) {
+	tm := NewTreeMap[string, int](WithTraversalOrder(InOrder))
+	tm.Put("b", 2)
+	tm.Put("a", 1)
+	tm.Put("c", 3)
+
+	d, err := json.Marshal(&tm)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"data":[{"key":"a","value":1},{"key":"b","value":2},{"key":"c","value":3}],"traversal_order":"inOrder"}`
+	if string(d) != want {
+		t.Errorf("MarshalJSON() got = %v, want %v", string(d), want)
+	}
+
+	ntm := NewTreeMap[string, int]()
+	if err := json.Unmarshal(d, &ntm); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ntm.Entries(), tm.Entries()) {
+		t.Errorf("UnmarshalJSON() got = %v, want %v", ntm.Entries(), tm.Entries())
+	}
+	if ntm.TraversalOrder() != InOrder {
+		t.Errorf("UnmarshalJSON() got = %v, want %v", ntm.TraversalOrder(), InOrder)
+	}
+}
+
+func TestTreeMap_Iterators(t *testing.T) {
+	tm := FromSeq2(maps.All(map[string]int{"c": 3, "a": 1, "b": 2}), WithSelfBalancing())
+
+	if got := slices.Collect(tm.Keys()); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("Keys() got %v, want %v", got, []string{"a", "b", "c"})
+	}
+	if got := slices.Collect(tm.Values()); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Values() got %v, want %v", got, []int{1, 2, 3})
+	}
+
+	m := maps.Collect(tm.All())
+	if !reflect.DeepEqual(m, map[string]int{"a": 1, "b": 2, "c": 3}) {
+		t.Errorf("All() got %v", m)
+	}
+}
+
+func TestTreeMap_ModifyWhileIterating(t *testing.T) {
+	tm := FromSeq2(maps.All(map[int]string{1: "a", 2: "b", 3: "c"}))
+
+	for k, v := range tm.All() {
+		tm.Put(k, v+v)
+		tm.Put(k*10, v)
+	}
+	for k := range tm.Range(10, 100) {
+		tm.Delete(k)
+	}
+
+	if got := maps.Collect(tm.All()); !reflect.DeepEqual(got, map[int]string{1: "aa", 2: "bb", 3: "cc"}) {
+		t.Errorf("got %v", got)
+	}
+}
+
+func TestTreeMap_IgnoresDuplicatePolicy(t *testing.T) {
+	for _, policy := range []DuplicatePolicy{RejectDuplicates, CountDuplicates} {
+		tm := NewTreeMap[string, int](WithDuplicates(policy))
+		tm.Put("a", 1)
+		tm.Put("a", 2)
+
+		if v, _ := tm.Get("a"); v != 2 || tm.Size() != 1 {
+			t.Errorf("%s: expected a to be replaced with 2 and size 1, got %v and %d", policy, v, tm.Size())
+		}
+
+		fs := FromSeq2(maps.All(map[string]int{"a": 1}), WithDuplicates(policy))
+		if v, _ := fs.Get("a"); v != 1 || fs.Size() != 1 {
+			t.Errorf("%s: expected FromSeq2 to hold a=1, got %v and %d", policy, v, fs.Size())
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package deque
+
+import (
+	"encoding/json"
+	"iter"
+	"sync"
+
+	"github.com/johannessarpola/gollections/internal/ring"
+	"github.com/johannessarpola/gollections/internal/seqs"
+)
+
+// Deque is a double-ended queue backed by a growable ring buffer, pushing and popping
+// at both ends and indexing are O(1) and do not allocate once the buffer has grown.
+type Deque[T any] struct {
+	values ring.Buffer[T]
+	mu     sync.Mutex
+}
+
+func NewDeque[T any]() Deque[T] {
+	return Deque[T]{}
+}
+
+// FromSeq creates a new deque with the values of seq pushed to the back in order.
+func FromSeq[T any](seq iter.Seq[T]) Deque[T] {
+	var values ring.Buffer[T]
+	for v := range seq {
+		values.PushBack(v)
+	}
+
+	return Deque[T]{
+		values: values,
+	}
+}
+
+func (d *Deque[T]) withLock(f func()) {
+	defer d.mu.Unlock()
+	d.mu.Lock()
+	f()
+}
+
+func (d *Deque[T]) PushFront(value T) {
+	d.withLock(func() {
+		d.values.PushFront(value)
+	})
+}
+
+func (d *Deque[T]) PushBack(value T) {
+	d.withLock(func() {
+		d.values.PushBack(value)
+	})
+}
+
+func (d *Deque[T]) PopFront() (T, bool) {
+	var (
+		v T
+		b bool
+	)
+	d.withLock(func() {
+		v, b = d.values.PopFront()
+	})
+	return v, b
+}
+
+func (d *Deque[T]) PopBack() (T, bool) {
+	var (
+		v T
+		b bool
+	)
+	d.withLock(func() {
+		v, b = d.values.PopBack()
+	})
+	return v, b
+}
+
+// Front returns the first value without removing it.
+func (d *Deque[T]) Front() (T, bool) {
+	var (
+		v T
+		b bool
+	)
+	d.withLock(func() {
+		v, b = d.values.Front()
+	})
+	return v, b
+}
+
+// Back returns the last value without removing it.
+func (d *Deque[T]) Back() (T, bool) {
+	var (
+		v T
+		b bool
+	)
+	d.withLock(func() {
+		v, b = d.values.Back()
+	})
+	return v, b
+}
+
+// At returns the value at index i counted from the front.
+func (d *Deque[T]) At(i int) (T, bool) {
+	var (
+		v T
+		b bool
+	)
+	d.withLock(func() {
+		v, b = d.values.At(i)
+	})
+	return v, b
+}
+
+func (d *Deque[T]) Size() int {
+	i := 0
+	d.withLock(func() {
+		i = d.values.Len()
+	})
+	return i
+}
+
+func (d *Deque[T]) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+func (d *Deque[T]) Clear() {
+	d.withLock(func() {
+		d.values.Clear()
+	})
+}
+
+// snapshot copies the values from front to back while holding the lock.
+func (d *Deque[T]) snapshot() []T {
+	var values []T
+	d.withLock(func() {
+		values = d.values.Slice()
+	})
+	return values
+}
+
+// All iterates the values with their indexes from front to back without removing them.
+func (d *Deque[T]) All() iter.Seq2[int, T] {
+	return seqs.Snapshot(d.snapshot)
+}
+
+// Backward iterates the values with their indexes from back to front without removing them.
+func (d *Deque[T]) Backward() iter.Seq2[int, T] {
+	return seqs.Backward(d.snapshot)
+}
+
+// Values iterates the values from front to back without removing them.
+func (d *Deque[T]) Values() iter.Seq[T] {
+	return seqs.Values(d.All())
+}
+
+// Items returns the values from front to back.
+func (d *Deque[T]) Items() []T {
+	return d.snapshot()
+}
+
+func (d *Deque[T]) UnmarshalJSON(data []byte) error {
+	var aux []T
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	d.withLock(func() {
+		for _, v := range aux {
+			d.values.PushBack(v)
+		}
+	})
+
+	return nil
+}
+
+// MarshalJSON marshals the values as an array from front to back.
+func (d *Deque[T]) MarshalJSON() ([]byte, error) {
+	items := d.Items()
+	return json.Marshal(items)
+}
@@ -0,0 +1,189 @@
+package deque
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestDeque(t *testing.T) {
+	tests := []struct {
+		name      string
+		ops       func(d *Deque[int])
+		want      []int
+		wantFront int
+		wantBack  int
+	}{
+		{
+			name: "push back",
+			ops: func(d *Deque[int]) {
+				d.PushBack(1)
+				d.PushBack(2)
+				d.PushBack(3)
+			},
+			want:      []int{1, 2, 3},
+			wantFront: 1,
+			wantBack:  3,
+		},
+		{
+			name: "push front",
+			ops: func(d *Deque[int]) {
+				d.PushFront(1)
+				d.PushFront(2)
+				d.PushFront(3)
+			},
+			want:      []int{3, 2, 1},
+			wantFront: 3,
+			wantBack:  1,
+		},
+		{
+			name: "mixed",
+			ops: func(d *Deque[int]) {
+				for i := range 10 {
+					d.PushBack(i)
+					d.PushFront(-i)
+				}
+				for range 9 {
+					d.PopFront()
+					d.PopBack()
+				}
+			},
+			want:      []int{0, 0},
+			wantFront: 0,
+			wantBack:  0,
+		},
+		{
+			name: "pop both ends",
+			ops: func(d *Deque[int]) {
+				for i := range 5 {
+					d.PushBack(i)
+				}
+				if v, ok := d.PopFront(); v != 0 || !ok {
+					t.Errorf("PopFront() got %d (%v), want 0 (true)", v, ok)
+				}
+				if v, ok := d.PopBack(); v != 4 || !ok {
+					t.Errorf("PopBack() got %d (%v), want 4 (true)", v, ok)
+				}
+			},
+			want:      []int{1, 2, 3},
+			wantFront: 1,
+			wantBack:  3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDeque[int]()
+			tt.ops(&d)
+
+			if got := d.Items(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Items() got %v, want %v", got, tt.want)
+			}
+			if d.Size() != len(tt.want) {
+				t.Errorf("Size() got %d, want %d", d.Size(), len(tt.want))
+			}
+			if v, ok := d.Front(); v != tt.wantFront || !ok {
+				t.Errorf("Front() got %d (%v), want %d", v, ok, tt.wantFront)
+			}
+			if v, ok := d.Back(); v != tt.wantBack || !ok {
+				t.Errorf("Back() got %d (%v), want %d", v, ok, tt.wantBack)
+			}
+			for i, want := range tt.want {
+				if v, ok := d.At(i); v != want || !ok {
+					t.Errorf("At(%d) got %d (%v), want %d", i, v, ok, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDeque_Empty(t *testing.T) {
+	d := FromSeq(slices.Values([]string{"a"}))
+	d.Clear()
+
+	if !d.IsEmpty() {
+		t.Errorf("expected deque to be empty after Clear")
+	}
+	if _, ok := d.PopFront(); ok {
+		t.Errorf("expected PopFront on empty deque to fail")
+	}
+	if _, ok := d.PopBack(); ok {
+		t.Errorf("expected PopBack on empty deque to fail")
+	}
+	if _, ok := d.At(0); ok {
+		t.Errorf("expected At on empty deque to fail")
+	}
+}
+
+func TestDeque_Iterators(t *testing.T) {
+	d := FromSeq(slices.Values([]int{1, 2, 3}))
+
+	if got := slices.Collect(d.Values()); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Values() got %v", got)
+	}
+
+	var back []int
+	for i, v := range d.Backward() {
+		if v != i+1 {
+			t.Errorf("Backward() got %d at index %d", v, i)
+		}
+		back = append(back, v)
+	}
+	if !reflect.DeepEqual(back, []int{3, 2, 1}) {
+		t.Errorf("Backward() got %v", back)
+	}
+
+	for v := range d.Values() {
+		d.PushBack(v * 10)
+	}
+	if got := d.Items(); !reflect.DeepEqual(got, []int{1, 2, 3, 10, 20, 30}) {
+		t.Errorf("got %v", got)
+	}
+}
+
+func TestDeque_JSON(t *testing.T) {
+	d := NewDeque[int]()
+	d.PushBack(2)
+	d.PushFront(1)
+	d.PushBack(3)
+
+	b, err := json.Marshal(&d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "[1,2,3]" {
+		t.Errorf("MarshalJSON() got = %v, want %v", string(b), "[1,2,3]")
+	}
+
+	nd := NewDeque[int]()
+	if err := json.Unmarshal(b, &nd); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(nd.Items(), d.Items()) {
+		t.Errorf("UnmarshalJSON() got %v, want %v", nd.Items(), d.Items())
+	}
+}
+
+func TestDeque_ThreadSafety(t *testing.T) {
+	d := NewDeque[int]()
+	wg := sync.WaitGroup{}
+	for i := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range 100 {
+				if j%2 == 0 {
+					d.PushBack(i)
+				} else {
+					d.PushFront(i)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if d.Size() != 1000 {
+		t.Errorf("expected 1000 values, got %d", d.Size())
+	}
+}
@@ -0,0 +1,352 @@
+package heap
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"slices"
+	"sync"
+
+	"github.com/johannessarpola/gollections/internal/ordering"
+	"github.com/johannessarpola/gollections/internal/seqs"
+)
+
+// Item is a handle to a value pushed to a PriorityQueue, it can be used to
+// update the value or to restore its position after the priority has changed.
+type Item[T any] struct {
+	value T
+	index int // index in the heap, -1 once the item has been removed
+	pq    *PriorityQueue[T]
+}
+
+// Value returns the value of the item.
+func (it *Item[T]) Value() T {
+	if it.pq == nil {
+		return it.value
+	}
+
+	var v T
+	it.pq.withLock(func() {
+		v = it.value
+	})
+	return v
+}
+
+// PriorityQueue is a binary heap which pops the value with the highest priority first,
+// a value has higher priority than another when compare orders it first. A zero value
+// queue is a min-heap by the natural order of T and panics on the first Push or Merge
+// when T is not an ordered type.
+type PriorityQueue[T any] struct {
+	items   []*Item[T]
+	compare func(a, b T) int
+	mu      sync.Mutex
+}
+
+// NewPriorityQueue creates a min-heap popping the smallest value first.
+func NewPriorityQueue[T cmp.Ordered]() PriorityQueue[T] {
+	return NewPriorityQueueFunc(cmp.Compare[T])
+}
+
+// NewPriorityQueueFunc creates a priority queue ordered by compare,
+// compare returns a negative number when a should be popped before b.
+func NewPriorityQueueFunc[T any](compare func(a, b T) int) PriorityQueue[T] {
+	return PriorityQueue[T]{
+		compare: compare,
+	}
+}
+
+// FromSeq creates a new min-heap with the values of seq.
+func FromSeq[T cmp.Ordered](seq iter.Seq[T]) PriorityQueue[T] {
+	return FromSeqFunc(seq, cmp.Compare[T])
+}
+
+// FromSeqFunc creates a new priority queue ordered by compare with the values of seq.
+func FromSeqFunc[T any](seq iter.Seq[T], compare func(a, b T) int) PriorityQueue[T] {
+	var items []*Item[T]
+	for v := range seq {
+		items = append(items, &Item[T]{value: v, index: len(items)})
+	}
+	heapify(items, compare)
+
+	return PriorityQueue[T]{
+		items:   items,
+		compare: compare,
+	}
+}
+
+// comparator returns the ordering of the queue, resolving the natural ordering of T once
+// for queues which were not created with a constructor. The lock must be held.
+func (pq *PriorityQueue[T]) comparator() func(a, b T) int {
+	if pq.compare == nil {
+		compare, ok := ordering.Natural[T]()
+		if !ok {
+			panic(fmt.Sprintf("no comparator for type %T, use NewPriorityQueueFunc", *new(T)))
+		}
+		pq.compare = compare
+	}
+	return pq.compare
+}
+
+func (pq *PriorityQueue[T]) withLock(f func()) {
+	defer pq.mu.Unlock()
+	pq.mu.Lock()
+	f()
+}
+
+func less[T any](items []*Item[T], i, j int, compare func(a, b T) int) bool {
+	return compare(items[i].value, items[j].value) < 0
+}
+
+func swap[T any](items []*Item[T], i, j int) {
+	items[i], items[j] = items[j], items[i]
+	items[i].index = i
+	items[j].index = j
+}
+
+// up moves the item at index i towards the root until the heap order holds.
+func up[T any](items []*Item[T], i int, compare func(a, b T) int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !less(items, i, parent, compare) {
+			return
+		}
+		swap(items, i, parent)
+		i = parent
+	}
+}
+
+// down moves the item at index i towards the leaves until the heap order holds,
+// it returns true if the item was moved.
+func down[T any](items []*Item[T], i int, compare func(a, b T) int) bool {
+	start := i
+	for {
+		smallest, left, right := i, 2*i+1, 2*i+2
+		if left < len(items) && less(items, left, smallest, compare) {
+			smallest = left
+		}
+		if right < len(items) && less(items, right, smallest, compare) {
+			smallest = right
+		}
+		if smallest == i {
+			return i > start
+		}
+		swap(items, i, smallest)
+		i = smallest
+	}
+}
+
+func fix[T any](items []*Item[T], i int, compare func(a, b T) int) {
+	if !down(items, i, compare) {
+		up(items, i, compare)
+	}
+}
+
+func heapify[T any](items []*Item[T], compare func(a, b T) int) {
+	for i := len(items)/2 - 1; i >= 0; i-- {
+		down(items, i, compare)
+	}
+}
+
+// owns tells if the item is in the queue, the lock must be held.
+func (pq *PriorityQueue[T]) owns(it *Item[T]) bool {
+	return it != nil && it.index >= 0 && it.index < len(pq.items) && pq.items[it.index] == it
+}
+
+// removeAt removes the item at index i, the lock must be held.
+func (pq *PriorityQueue[T]) removeAt(i int) *Item[T] {
+	last := len(pq.items) - 1
+	if i != last {
+		swap(pq.items, i, last)
+	}
+
+	it := pq.items[last]
+	pq.items[last] = nil
+	pq.items = pq.items[:last]
+	if i != last {
+		fix(pq.items, i, pq.comparator())
+	}
+
+	it.index = -1
+	return it
+}
+
+// Push adds value to the queue and returns its handle.
+func (pq *PriorityQueue[T]) Push(value T) *Item[T] {
+	it := &Item[T]{value: value, pq: pq}
+	pq.withLock(func() {
+		it.index = len(pq.items)
+		pq.items = append(pq.items, it)
+		up(pq.items, it.index, pq.comparator())
+	})
+	return it
+}
+
+// Pop removes and returns the value with the highest priority.
+func (pq *PriorityQueue[T]) Pop() (T, bool) {
+	var (
+		v T
+		b bool
+	)
+
+	pq.withLock(func() {
+		if len(pq.items) > 0 {
+			v = pq.removeAt(0).value
+			b = true
+		}
+	})
+
+	return v, b
+}
+
+// Peek returns the value with the highest priority without removing it.
+func (pq *PriorityQueue[T]) Peek() (T, bool) {
+	var (
+		v T
+		b bool
+	)
+
+	pq.withLock(func() {
+		if len(pq.items) > 0 {
+			v = pq.items[0].value
+			b = true
+		}
+	})
+
+	return v, b
+}
+
+// Update replaces the value of the item and moves it to its new position,
+// it returns false if the item is no longer in the queue.
+func (pq *PriorityQueue[T]) Update(it *Item[T], value T) bool {
+	b := false
+	pq.withLock(func() {
+		if pq.owns(it) {
+			it.value = value
+			fix(pq.items, it.index, pq.comparator())
+			b = true
+		}
+	})
+	return b
+}
+
+// Fix restores the position of the item after the priority of its value has been changed in place,
+// for example through a pointer. It returns false if the item is no longer in the queue.
+func (pq *PriorityQueue[T]) Fix(it *Item[T]) bool {
+	b := false
+	pq.withLock(func() {
+		if pq.owns(it) {
+			fix(pq.items, it.index, pq.comparator())
+			b = true
+		}
+	})
+	return b
+}
+
+// Remove removes the item from the queue and returns its value,
+// it returns false if the item is no longer in the queue.
+func (pq *PriorityQueue[T]) Remove(it *Item[T]) (T, bool) {
+	var (
+		v T
+		b bool
+	)
+
+	pq.withLock(func() {
+		if pq.owns(it) {
+			v = pq.removeAt(it.index).value
+			b = true
+		}
+	})
+
+	return v, b
+}
+
+// Merge adds the values of another queue to this queue, another is left unchanged.
+func (pq *PriorityQueue[T]) Merge(another *PriorityQueue[T]) *PriorityQueue[T] {
+	// the values are copied before taking the lock so merging a queue with itself does not deadlock
+	values := another.snapshot()
+	pq.withLock(func() {
+		for _, v := range values {
+			pq.items = append(pq.items, &Item[T]{value: v, index: len(pq.items), pq: pq})
+		}
+		heapify(pq.items, pq.comparator())
+	})
+	return pq
+}
+
+func (pq *PriorityQueue[T]) Size() int {
+	i := 0
+	pq.withLock(func() {
+		i = len(pq.items)
+	})
+	return i
+}
+
+func (pq *PriorityQueue[T]) IsEmpty() bool {
+	return pq.Size() == 0
+}
+
+func (pq *PriorityQueue[T]) Clear() {
+	pq.withLock(func() {
+		for _, it := range pq.items {
+			it.index = -1
+		}
+		pq.items = nil
+	})
+}
+
+// snapshot copies the values in priority order while holding the lock.
+func (pq *PriorityQueue[T]) snapshot() []T {
+	var values []T
+	pq.withLock(func() {
+		values = make([]T, 0, len(pq.items))
+		for _, it := range pq.items {
+			values = append(values, it.value)
+		}
+		if len(values) > 1 {
+			slices.SortStableFunc(values, pq.comparator())
+		}
+	})
+	return values
+}
+
+// All iterates the values with their indexes in priority order without popping them.
+func (pq *PriorityQueue[T]) All() iter.Seq2[int, T] {
+	return seqs.Snapshot(pq.snapshot)
+}
+
+// Values iterates the values in priority order without popping them.
+func (pq *PriorityQueue[T]) Values() iter.Seq[T] {
+	return seqs.Values(pq.All())
+}
+
+// Items returns the values in priority order.
+func (pq *PriorityQueue[T]) Items() []T {
+	return pq.snapshot()
+}
+
+func (pq *PriorityQueue[T]) UnmarshalJSON(data []byte) error {
+	var aux []T
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if pq.compare == nil {
+		if _, ok := ordering.Natural[T](); !ok {
+			return fmt.Errorf("priority queue of %T has no comparator, create it with NewPriorityQueueFunc", *new(T))
+		}
+	}
+
+	for _, v := range aux {
+		pq.Push(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON marshals the values as an array in priority order.
+func (pq *PriorityQueue[T]) MarshalJSON() ([]byte, error) {
+	items := pq.Items()
+	return json.Marshal(items)
+}
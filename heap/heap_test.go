@@ -0,0 +1,222 @@
+package heap
+
+import (
+	"cmp"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func drain[T any](pq *PriorityQueue[T]) []T {
+	var values []T
+	for {
+		v, ok := pq.Pop()
+		if !ok {
+			return values
+		}
+		values = append(values, v)
+	}
+}
+
+func TestPriorityQueue_PushPop(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  []int
+	}{
+		{name: "empty", input: nil, want: nil},
+		{name: "single", input: []int{1}, want: []int{1}},
+		{name: "unordered", input: []int{5, 3, 8, 1, 9, 2}, want: []int{1, 2, 3, 5, 8, 9}},
+		{name: "duplicates", input: []int{2, 1, 2, 1}, want: []int{1, 1, 2, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pq := NewPriorityQueue[int]()
+			for _, v := range tt.input {
+				pq.Push(v)
+			}
+			if pq.Size() != len(tt.input) {
+				t.Errorf("Size() got %d, want %d", pq.Size(), len(tt.input))
+			}
+			if len(tt.want) > 0 {
+				if v, ok := pq.Peek(); v != tt.want[0] || !ok {
+					t.Errorf("Peek() got %d (%v), want %d (true)", v, ok, tt.want[0])
+				}
+			}
+			if got := drain(&pq); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Pop() order got %v, want %v", got, tt.want)
+			}
+			if _, ok := pq.Peek(); ok || !pq.IsEmpty() {
+				t.Errorf("expected queue to be empty")
+			}
+		})
+	}
+}
+
+func TestPriorityQueueFunc(t *testing.T) {
+	type task struct {
+		name     string
+		priority int
+	}
+
+	// the highest priority is popped first
+	pq := NewPriorityQueueFunc(func(a, b *task) int {
+		return cmp.Compare(b.priority, a.priority)
+	})
+
+	low := &task{"low", 1}
+	mid := &task{"mid", 5}
+	high := &task{"high", 10}
+	lowItem := pq.Push(low)
+	pq.Push(mid)
+	highItem := pq.Push(high)
+
+	low.priority = 20
+	if !pq.Fix(lowItem) {
+		t.Errorf("expected Fix() to succeed")
+	}
+	if v, _ := pq.Peek(); v != low {
+		t.Errorf("Peek() after Fix() got %v, want %v", v.name, low.name)
+	}
+
+	if !pq.Update(highItem, &task{"urgent", 30}) {
+		t.Errorf("expected Update() to succeed")
+	}
+	if highItem.Value().name != "urgent" {
+		t.Errorf("Value() got %v, want urgent", highItem.Value().name)
+	}
+
+	var names []string
+	for _, v := range drain(&pq) {
+		names = append(names, v.name)
+	}
+	if want := []string{"urgent", "low", "mid"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("got %v, want %v", names, want)
+	}
+
+	if pq.Update(highItem, &task{"stale", 0}) || pq.Fix(lowItem) {
+		t.Errorf("expected handles of popped items to be rejected")
+	}
+}
+
+func TestPriorityQueue_Remove(t *testing.T) {
+	pq := NewPriorityQueue[int]()
+	items := map[int]*Item[int]{}
+	for _, v := range []int{7, 3, 9, 1, 5} {
+		items[v] = pq.Push(v)
+	}
+
+	if v, ok := pq.Remove(items[3]); v != 3 || !ok {
+		t.Errorf("Remove() got %d (%v), want 3 (true)", v, ok)
+	}
+	if _, ok := pq.Remove(items[3]); ok {
+		t.Errorf("expected item to be already removed")
+	}
+
+	other := NewPriorityQueue[int]()
+	foreign := other.Push(1)
+	if _, ok := pq.Remove(foreign); ok {
+		t.Errorf("expected foreign item to be rejected")
+	}
+
+	if got := drain(&pq); !reflect.DeepEqual(got, []int{1, 5, 7, 9}) {
+		t.Errorf("got %v, want %v", got, []int{1, 5, 7, 9})
+	}
+}
+
+func TestPriorityQueue_Merge(t *testing.T) {
+	a := FromSeq(slices.Values([]int{5, 1, 3}))
+	b := FromSeq(slices.Values([]int{4, 2}))
+
+	a.Merge(&b)
+	if b.Size() != 2 {
+		t.Errorf("expected merged queue to be unchanged, got size %d", b.Size())
+	}
+
+	a.Merge(&a)
+	if got := drain(&a); !reflect.DeepEqual(got, []int{1, 1, 2, 2, 3, 3, 4, 4, 5, 5}) {
+		t.Errorf("got %v", got)
+	}
+}
+
+func TestPriorityQueue_Iterators(t *testing.T) {
+	pq := FromSeq(slices.Values([]string{"c", "a", "d", "b"}))
+
+	if got := slices.Collect(pq.Values()); !reflect.DeepEqual(got, []string{"a", "b", "c", "d"}) {
+		t.Errorf("Values() got %v", got)
+	}
+	for i, v := range pq.All() {
+		if i == 0 {
+			pq.Push("0")
+		}
+		if v == "0" {
+			t.Errorf("expected iteration to not see values pushed during it")
+		}
+	}
+	if pq.Size() != 5 {
+		t.Errorf("expected iteration to not pop values, got size %d", pq.Size())
+	}
+}
+
+func TestPriorityQueue_JSON(t *testing.T) {
+	pq := FromSeq(slices.Values([]int{3, 1, 2}))
+
+	d, err := json.Marshal(&pq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(d) != "[1,2,3]" {
+		t.Errorf("MarshalJSON() got = %v, want %v", string(d), "[1,2,3]")
+	}
+
+	npq := NewPriorityQueue[int]()
+	if err := json.Unmarshal([]byte("[9,4,6]"), &npq); err != nil {
+		t.Fatal(err)
+	}
+	if got := drain(&npq); !reflect.DeepEqual(got, []int{4, 6, 9}) {
+		t.Errorf("UnmarshalJSON() got %v", got)
+	}
+
+	var zero PriorityQueue[int]
+	if err := json.Unmarshal([]byte("[3,1,2]"), &zero); err != nil {
+		t.Fatal(err)
+	}
+	if got := drain(&zero); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("UnmarshalJSON() into zero value got %v", got)
+	}
+
+	var unordered PriorityQueue[struct{ N int }]
+	if err := json.Unmarshal([]byte(`[{"N":1}]`), &unordered); err == nil {
+		t.Errorf("expected error for queue without comparator")
+	}
+}
+
+func TestPriorityQueue_ZeroValue(t *testing.T) {
+	var pq PriorityQueue[int]
+	pq.Push(2)
+	pq.Push(1)
+	it := pq.Push(3)
+	pq.Update(it, 0)
+
+	var other PriorityQueue[int]
+	other.Merge(&pq)
+
+	if got := drain(&other); !reflect.DeepEqual(got, []int{0, 1, 2}) {
+		t.Errorf("got %v, want [0 1 2]", got)
+	}
+	if got := drain(&pq); !reflect.DeepEqual(got, []int{0, 1, 2}) {
+		t.Errorf("got %v, want [0 1 2]", got)
+	}
+
+	var unordered PriorityQueue[struct{}]
+	if len(unordered.Items()) != 0 {
+		t.Errorf("expected empty zero value to be readable")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected panic on first push of an unordered type")
+		}
+	}()
+	unordered.Push(struct{}{})
+}
@@ -0,0 +1,43 @@
+package node
+
+import "fmt"
+
+// TreeNode is a node of a binary search tree, Prev is the left and Next the right child.
+type TreeNode[T any] struct {
+	Inner  T
+	Next   *TreeNode[T]
+	Prev   *TreeNode[T]
+	Count  int // multiplicity of the value
+	Height int // height of the subtree rooted at this node
+	Size   int // number of values in the subtree rooted at this node, including multiplicities
+}
+
+// Get returns the value of the node, bool is false if the node is nil.
+func (n *TreeNode[T]) Get() (T, bool) {
+	var zv T
+	if n == nil {
+		return zv, false
+	}
+	return n.Inner, true
+}
+
+func (n *TreeNode[T]) String() string {
+	return fmt.Sprintf("%v", n.Inner)
+}
+
+func (n *TreeNode[T]) HasPrev() bool {
+	return n.Prev != nil
+}
+
+func (n *TreeNode[T]) HasNext() bool {
+	return n.Next != nil
+}
+
+func NewTreeNode[T any](value T) TreeNode[T] {
+	return TreeNode[T]{
+		Inner:  value,
+		Count:  1,
+		Height: 1,
+		Size:   1,
+	}
+}
@@ -0,0 +1,42 @@
+package node
+
+import "testing"
+
+func TestTreeNode(t *testing.T) {
+	n := NewTreeNode(5)
+
+	if v, b := n.Get(); v != 5 || !b {
+		t.Errorf("got %v (%v), want %v", v, b, 5)
+	}
+
+	if n.Height != 1 {
+		t.Errorf("new node height should be 1, got %d", n.Height)
+	}
+
+	if n.Count != 1 {
+		t.Errorf("new node count should be 1, got %d", n.Count)
+	}
+
+	if n.Size != 1 {
+		t.Errorf("new node size should be 1, got %d", n.Size)
+	}
+
+	if n.HasPrev() || n.HasNext() {
+		t.Errorf("new node should not have children")
+	}
+
+	var empty *TreeNode[int]
+	if v, b := empty.Get(); v != 0 || b {
+		t.Errorf("nil node should return zero value and false, got %v (%v)", v, b)
+	}
+
+	// zero values are still present values in tree nodes
+	z := NewTreeNode(0)
+	if _, b := z.Get(); !b {
+		t.Errorf("zero valued node should be present")
+	}
+
+	if n.String() != "5" {
+		t.Errorf("got %v, want %v", n.String(), "5")
+	}
+}
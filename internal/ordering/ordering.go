@@ -0,0 +1,71 @@
+// Package ordering resolves the natural ordering of a type parameter which is not
+// constrained to cmp.Ordered, for collections which work as zero values.
+package ordering
+
+import (
+	"cmp"
+	"reflect"
+)
+
+// compareAs returns cmp.Compare for T when T is the ordered type O.
+func compareAs[T any, O cmp.Ordered]() func(a, b T) int {
+	return any(cmp.Compare[O]).(func(a, b T) int)
+}
+
+// Natural returns the natural ordering of T for ordered kinds (integers, floats and strings),
+// named types of those kinds are compared through reflect. It returns false for other kinds.
+// The ordering should be resolved once and stored, not on every comparison.
+func Natural[T any]() (func(a, b T) int, bool) {
+	var zero T
+	switch any(zero).(type) {
+	case int:
+		return compareAs[T, int](), true
+	case int8:
+		return compareAs[T, int8](), true
+	case int16:
+		return compareAs[T, int16](), true
+	case int32:
+		return compareAs[T, int32](), true
+	case int64:
+		return compareAs[T, int64](), true
+	case uint:
+		return compareAs[T, uint](), true
+	case uint8:
+		return compareAs[T, uint8](), true
+	case uint16:
+		return compareAs[T, uint16](), true
+	case uint32:
+		return compareAs[T, uint32](), true
+	case uint64:
+		return compareAs[T, uint64](), true
+	case uintptr:
+		return compareAs[T, uintptr](), true
+	case float32:
+		return compareAs[T, float32](), true
+	case float64:
+		return compareAs[T, float64](), true
+	case string:
+		return compareAs[T, string](), true
+	}
+
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(a, b T) int {
+			return cmp.Compare(reflect.ValueOf(a).Int(), reflect.ValueOf(b).Int())
+		}, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(a, b T) int {
+			return cmp.Compare(reflect.ValueOf(a).Uint(), reflect.ValueOf(b).Uint())
+		}, true
+	case reflect.Float32, reflect.Float64:
+		return func(a, b T) int {
+			return cmp.Compare(reflect.ValueOf(a).Float(), reflect.ValueOf(b).Float())
+		}, true
+	case reflect.String:
+		return func(a, b T) int {
+			return cmp.Compare(reflect.ValueOf(a).String(), reflect.ValueOf(b).String())
+		}, true
+	default:
+		return nil, false
+	}
+}
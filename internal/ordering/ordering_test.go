@@ -0,0 +1,44 @@
+package ordering
+
+import (
+	"testing"
+)
+
+type celsius float64
+
+func compare[T any](a, b T) int {
+	f, ok := Natural[T]()
+	if !ok {
+		panic("no natural ordering")
+	}
+	return f(a, b)
+}
+
+func TestNatural(t *testing.T) {
+	tests := []struct {
+		name string
+		got  int
+		want int
+	}{
+		{name: "int-less", got: compare(1, 2), want: -1},
+		{name: "int-equal", got: compare(2, 2), want: 0},
+		{name: "uint-greater", got: compare[uint8](3, 2), want: 1},
+		{name: "float-less", got: compare(1.5, 2.5), want: -1},
+		{name: "string-greater", got: compare("b", "a"), want: 1},
+		{name: "named-float", got: compare[celsius](-3, 3), want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %d, want %d", tt.got, tt.want)
+			}
+		})
+	}
+
+	if _, ok := Natural[struct{}](); ok {
+		t.Errorf("expected no natural ordering for a struct")
+	}
+	if _, ok := Natural[any](); ok {
+		t.Errorf("expected no natural ordering for an interface")
+	}
+}
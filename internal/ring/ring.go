@@ -0,0 +1,107 @@
+package ring
+
+// minCapacity is the size of the first allocation, capacities are kept as powers of two
+// so the physical index can be found with a mask.
+const minCapacity = 8
+
+// Buffer is a growable ring buffer which can be pushed to and popped from both ends.
+// It is not safe for concurrent use, the collections using it guard it with their own lock.
+type Buffer[T any] struct {
+	buf  []T
+	head int
+	size int
+}
+
+// index returns the physical index of the logical index i.
+func (b *Buffer[T]) index(i int) int {
+	return (b.head + i) & (len(b.buf) - 1)
+}
+
+// grow doubles the capacity when the buffer is full, the values are copied so they start from index zero.
+func (b *Buffer[T]) grow() {
+	if b.size < len(b.buf) {
+		return
+	}
+
+	capacity := max(minCapacity, len(b.buf)*2)
+	buf := make([]T, capacity)
+	n := copy(buf, b.buf[b.head:])
+	copy(buf[n:], b.buf[:b.head])
+	b.buf = buf
+	b.head = 0
+}
+
+func (b *Buffer[T]) Len() int {
+	return b.size
+}
+
+func (b *Buffer[T]) PushBack(value T) {
+	b.grow()
+	b.buf[b.index(b.size)] = value
+	b.size++
+}
+
+func (b *Buffer[T]) PushFront(value T) {
+	b.grow()
+	b.head = b.index(len(b.buf) - 1)
+	b.buf[b.head] = value
+	b.size++
+}
+
+func (b *Buffer[T]) PopFront() (T, bool) {
+	var zero T
+	if b.size == 0 {
+		return zero, false
+	}
+
+	v := b.buf[b.head]
+	b.buf[b.head] = zero // release the reference for GC
+	b.head = b.index(1)
+	b.size--
+	return v, true
+}
+
+func (b *Buffer[T]) PopBack() (T, bool) {
+	var zero T
+	if b.size == 0 {
+		return zero, false
+	}
+
+	i := b.index(b.size - 1)
+	v := b.buf[i]
+	b.buf[i] = zero // release the reference for GC
+	b.size--
+	return v, true
+}
+
+// At returns the value at logical index i counted from the front.
+func (b *Buffer[T]) At(i int) (T, bool) {
+	var zero T
+	if i < 0 || i >= b.size {
+		return zero, false
+	}
+	return b.buf[b.index(i)], true
+}
+
+func (b *Buffer[T]) Front() (T, bool) {
+	return b.At(0)
+}
+
+func (b *Buffer[T]) Back() (T, bool) {
+	return b.At(b.size - 1)
+}
+
+// Clear removes the values but keeps the allocated capacity.
+func (b *Buffer[T]) Clear() {
+	clear(b.buf)
+	b.head, b.size = 0, 0
+}
+
+// Slice returns a copy of the values from front to back.
+func (b *Buffer[T]) Slice() []T {
+	values := make([]T, b.size)
+	for i := range values {
+		values[i] = b.buf[b.index(i)]
+	}
+	return values
+}
@@ -0,0 +1,133 @@
+package ring
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuffer(t *testing.T) {
+	tests := []struct {
+		name string
+		ops  func(b *Buffer[int])
+		want []int
+	}{
+		{
+			name: "empty",
+			ops:  func(b *Buffer[int]) {},
+			want: []int{},
+		},
+		{
+			name: "push back",
+			ops: func(b *Buffer[int]) {
+				for i := range 20 {
+					b.PushBack(i)
+				}
+			},
+			want: []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19},
+		},
+		{
+			name: "push front",
+			ops: func(b *Buffer[int]) {
+				for i := range 10 {
+					b.PushFront(i)
+				}
+			},
+			want: []int{9, 8, 7, 6, 5, 4, 3, 2, 1, 0},
+		},
+		{
+			name: "wrap around before growing",
+			ops: func(b *Buffer[int]) {
+				for i := range 6 {
+					b.PushBack(i)
+				}
+				for range 4 {
+					b.PopFront()
+				}
+				for i := 6; i < 14; i++ {
+					b.PushBack(i)
+				}
+				b.PushFront(3)
+			},
+			want: []int{3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13},
+		},
+		{
+			name: "pop both ends",
+			ops: func(b *Buffer[int]) {
+				for i := range 5 {
+					b.PushBack(i)
+				}
+				b.PopFront()
+				b.PopBack()
+			},
+			want: []int{1, 2, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b Buffer[int]
+			tt.ops(&b)
+
+			if got := b.Slice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Slice() got %v, want %v", got, tt.want)
+			}
+			if b.Len() != len(tt.want) {
+				t.Errorf("Len() got %d, want %d", b.Len(), len(tt.want))
+			}
+			for i, want := range tt.want {
+				if v, ok := b.At(i); v != want || !ok {
+					t.Errorf("At(%d) got %d (%v), want %d", i, v, ok, want)
+				}
+			}
+			if _, ok := b.At(len(tt.want)); ok {
+				t.Errorf("expected At(%d) to be out of range", len(tt.want))
+			}
+		})
+	}
+}
+
+func TestBuffer_Empty(t *testing.T) {
+	var b Buffer[string]
+
+	if _, ok := b.PopFront(); ok {
+		t.Errorf("expected PopFront on empty buffer to fail")
+	}
+	if _, ok := b.PopBack(); ok {
+		t.Errorf("expected PopBack on empty buffer to fail")
+	}
+	if _, ok := b.Front(); ok {
+		t.Errorf("expected Front on empty buffer to fail")
+	}
+	if _, ok := b.Back(); ok {
+		t.Errorf("expected Back on empty buffer to fail")
+	}
+
+	b.PushBack("a")
+	b.Clear()
+	if b.Len() != 0 {
+		t.Errorf("expected buffer to be empty after Clear")
+	}
+}
+
+func TestBuffer_Allocations(t *testing.T) {
+	var b Buffer[int]
+	for i := range 64 {
+		b.PushBack(i)
+	}
+	for range 64 {
+		b.PopFront()
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		for i := range 32 {
+			b.PushBack(i)
+			b.PushFront(i)
+		}
+		for range 32 {
+			b.PopFront()
+			b.PopBack()
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("expected no allocations once the buffer has grown, got %v", allocs)
+	}
+}
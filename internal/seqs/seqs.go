@@ -0,0 +1,83 @@
+// Package seqs holds the iterator helpers shared by the collections.
+//
+// The collections iterate over a snapshot of their values which is copied while holding
+// the lock of the collection. The copy is yielded without the lock, so a collection can be
+// used, and modified, inside a range loop over itself without deadlocking.
+package seqs
+
+import (
+	"iter"
+	"slices"
+)
+
+// Snapshot iterates the values returned by snapshot with their indexes, snapshot is called
+// each time the iteration starts.
+func Snapshot[T any](snapshot func() []T) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range snapshot() {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// Backward iterates the values returned by snapshot with their indexes in reverse order.
+func Backward[T any](snapshot func() []T) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range slices.Backward(snapshot()) {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// SnapshotValues iterates the values returned by snapshot without their indexes.
+func SnapshotValues[T any](snapshot func() []T) iter.Seq[T] {
+	return Values(Snapshot(snapshot))
+}
+
+// SnapshotPairs iterates the values returned by snapshot split into pairs by f.
+func SnapshotPairs[E, K, V any](snapshot func() []E, f func(E) (K, V)) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, e := range snapshot() {
+			if !yield(f(e)) {
+				return
+			}
+		}
+	}
+}
+
+// SnapshotMap iterates the key value pairs of the map returned by snapshot.
+func SnapshotMap[K comparable, V any](snapshot func() map[K]V) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range snapshot() {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Keys iterates the first element of each pair of seq.
+func Keys[K, V any](seq iter.Seq2[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range seq {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values iterates the second element of each pair of seq.
+func Values[K, V any](seq iter.Seq2[K, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range seq {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
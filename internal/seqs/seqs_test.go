@@ -0,0 +1,117 @@
+package seqs
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestSnapshot(t *testing.T) {
+	values := []int{1, 2, 3}
+	calls := 0
+	seq := Snapshot(func() []int {
+		calls++
+		return values
+	})
+
+	if calls != 0 {
+		t.Errorf("expected snapshot to be taken lazily, got %d calls", calls)
+	}
+
+	got := maps.Collect(seq)
+	if want := map[int]int{0: 1, 1: 2, 2: 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	values = []int{4}
+	if got := slices.Collect(Values(seq)); !reflect.DeepEqual(got, []int{4}) {
+		t.Errorf("expected a new snapshot for each iteration, got %v", got)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+}
+
+func TestBackward(t *testing.T) {
+	var (
+		indexes []int
+		values  []string
+	)
+	for i, v := range Backward(func() []string { return []string{"a", "b", "c"} }) {
+		indexes = append(indexes, i)
+		values = append(values, v)
+	}
+
+	if !reflect.DeepEqual(indexes, []int{2, 1, 0}) || !reflect.DeepEqual(values, []string{"c", "b", "a"}) {
+		t.Errorf("got %v %v", indexes, values)
+	}
+}
+
+func TestKeysValuesBreak(t *testing.T) {
+	seq := slices.All([]string{"a", "b", "c"})
+
+	for k := range Keys(seq) {
+		if k != 0 {
+			t.Errorf("expected to stop after the first key, got %d", k)
+		}
+		break
+	}
+	for v := range Values(seq) {
+		if v != "a" {
+			t.Errorf("expected to stop after the first value, got %s", v)
+		}
+		break
+	}
+
+	if got := slices.Collect(Keys(seq)); !reflect.DeepEqual(got, []int{0, 1, 2}) {
+		t.Errorf("got %v", got)
+	}
+}
+
+func TestSnapshotValues(t *testing.T) {
+	got := slices.Collect(SnapshotValues(func() []int { return []int{3, 1, 2} }))
+	if !reflect.DeepEqual(got, []int{3, 1, 2}) {
+		t.Errorf("got %v", got)
+	}
+}
+
+func TestSnapshotPairs(t *testing.T) {
+	type entry struct {
+		key   string
+		value int
+	}
+	seq := SnapshotPairs(
+		func() []entry { return []entry{{"a", 1}, {"b", 2}, {"c", 3}} },
+		func(e entry) (string, int) { return e.key, e.value },
+	)
+
+	var keys []string
+	for k, v := range seq {
+		keys = append(keys, k)
+		if v == 2 {
+			break
+		}
+	}
+	if !reflect.DeepEqual(keys, []string{"a", "b"}) {
+		t.Errorf("expected to stop after b, got %v", keys)
+	}
+}
+
+func TestSnapshotMap(t *testing.T) {
+	calls := 0
+	seq := SnapshotMap(func() map[string]int {
+		calls++
+		return map[string]int{"a": 1, "b": 2}
+	})
+
+	if got := maps.Collect(seq); !reflect.DeepEqual(got, map[string]int{"a": 1, "b": 2}) {
+		t.Errorf("got %v", got)
+	}
+	for range seq {
+		break
+	}
+	if calls != 2 {
+		t.Errorf("expected a snapshot for each iteration, got %d calls", calls)
+	}
+}
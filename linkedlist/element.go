@@ -0,0 +1,153 @@
+package linkedlist
+
+import "sync/atomic"
+
+// Element is a handle to a value stored in a LinkedList. Handles are returned by
+// Append, Prepend, Find, Front and Back and allow O(1) insertion, moving and removal.
+type Element[T comparable] struct {
+	Value T
+	next  *Element[T]
+	prev  *Element[T]
+	list  atomic.Pointer[LinkedList[T]] // read without the lock by Next and Prev, re-checked under it
+}
+
+// Next returns the element after e or nil if e is the last element or no longer in a list.
+func (e *Element[T]) Next() *Element[T] {
+	return e.neighbour(func() *Element[T] { return e.next })
+}
+
+// Prev returns the element before e or nil if e is the first element or no longer in a list.
+func (e *Element[T]) Prev() *Element[T] {
+	return e.neighbour(func() *Element[T] { return e.prev })
+}
+
+func (e *Element[T]) neighbour(f func() *Element[T]) *Element[T] {
+	l := e.list.Load()
+	if l == nil {
+		return nil
+	}
+
+	var n *Element[T]
+	l.withLock(func() {
+		if e.list.Load() == l {
+			n = f()
+		}
+	})
+	return n
+}
+
+// Front returns the first element of the list or nil if the list is empty.
+func (l *LinkedList[T]) Front() *Element[T] {
+	var e *Element[T]
+	l.withLock(func() {
+		l.lazyBind()
+		e = l.head
+	})
+	return e
+}
+
+// Back returns the last element of the list or nil if the list is empty.
+func (l *LinkedList[T]) Back() *Element[T] {
+	var e *Element[T]
+	l.withLock(func() {
+		l.lazyBind()
+		e = l.tail
+	})
+	return e
+}
+
+// Find returns the element of the first occurrence of value or nil if it is not present.
+func (l *LinkedList[T]) Find(value T) *Element[T] {
+	var e *Element[T]
+	l.withLock(func() {
+		l.lazyBind()
+		e = l.find(value)
+	})
+	return e
+}
+
+// InsertBefore inserts value before mark and returns its element.
+// It returns nil if mark is not an element of the list.
+func (l *LinkedList[T]) InsertBefore(value T, mark *Element[T]) *Element[T] {
+	var e *Element[T]
+	l.withLock(func() {
+		if l.owns(mark) {
+			e = &Element[T]{Value: value}
+			l.insertBefore(e, mark)
+		}
+	})
+	return e
+}
+
+// InsertAfter inserts value after mark and returns its element.
+// It returns nil if mark is not an element of the list.
+func (l *LinkedList[T]) InsertAfter(value T, mark *Element[T]) *Element[T] {
+	var e *Element[T]
+	l.withLock(func() {
+		if !l.owns(mark) {
+			return
+		}
+		e = &Element[T]{Value: value}
+		if mark.next == nil {
+			l.pushBack(e)
+		} else {
+			l.insertBefore(e, mark.next)
+		}
+	})
+	return e
+}
+
+// MoveToFront moves e to the front of the list, nothing is done if e is not an element of the list.
+func (l *LinkedList[T]) MoveToFront(e *Element[T]) {
+	l.withLock(func() {
+		if l.owns(e) && l.head != e {
+			l.unlink(e)
+			l.pushFront(e)
+		}
+	})
+}
+
+// MoveToBack moves e to the back of the list, nothing is done if e is not an element of the list.
+func (l *LinkedList[T]) MoveToBack(e *Element[T]) {
+	l.withLock(func() {
+		if l.owns(e) && l.tail != e {
+			l.unlink(e)
+			l.pushBack(e)
+		}
+	})
+}
+
+// RemoveElement removes e from the list and returns its value.
+// It returns false if e is not an element of the list.
+func (l *LinkedList[T]) RemoveElement(e *Element[T]) (T, bool) {
+	var (
+		v T
+		b bool
+	)
+
+	l.withLock(func() {
+		if l.owns(e) {
+			v = e.Value
+			b = true
+			l.unlink(e)
+		}
+	})
+
+	return v, b
+}
+
+// owns tells if e is an element of the list, the lock must be held.
+func (l *LinkedList[T]) owns(e *Element[T]) bool {
+	return e != nil && e.list.Load() == l
+}
+
+// lazyBind binds the elements created by FromSeq to the list, the address of the list
+// is only known after it has been returned. The lock must be held.
+func (l *LinkedList[T]) lazyBind() {
+	if l.head == nil || l.head.list.Load() == l {
+		return
+	}
+	for current := l.head; current != nil; current = current.next {
+		current.list.Store(l)
+	}
+}
@@ -0,0 +1,227 @@
+package linkedlist
+
+import (
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestElement_InsertAndMove(t *testing.T) {
+	tests := []struct {
+		name string
+		ops  func(l *LinkedList[int])
+		want []int
+	}{
+		{
+			name: "insert before and after",
+			ops: func(l *LinkedList[int]) {
+				e := l.Append(2)
+				l.InsertBefore(1, e)
+				l.InsertAfter(3, e)
+			},
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "insert after tail",
+			ops: func(l *LinkedList[int]) {
+				l.Append(1)
+				e := l.Append(2)
+				l.InsertAfter(3, e)
+				l.Append(4)
+			},
+			want: []int{1, 2, 3, 4},
+		},
+		{
+			name: "move to front",
+			ops: func(l *LinkedList[int]) {
+				l.Append(1)
+				l.Append(2)
+				e := l.Append(3)
+				l.MoveToFront(e)
+			},
+			want: []int{3, 1, 2},
+		},
+		{
+			name: "move to back",
+			ops: func(l *LinkedList[int]) {
+				e := l.Prepend(1)
+				l.Append(2)
+				l.Append(3)
+				l.MoveToBack(e)
+			},
+			want: []int{2, 3, 1},
+		},
+		{
+			name: "remove element",
+			ops: func(l *LinkedList[int]) {
+				l.Append(1)
+				e := l.Append(2)
+				l.Append(3)
+				if v, ok := l.RemoveElement(e); v != 2 || !ok {
+					t.Errorf("RemoveElement() got %d (%v), want 2 (true)", v, ok)
+				}
+				if _, ok := l.RemoveElement(e); ok {
+					t.Errorf("expected element to be already removed")
+				}
+			},
+			want: []int{1, 3},
+		},
+		{
+			name: "foreign element",
+			ops: func(l *LinkedList[int]) {
+				other := NewLinkedList[int]()
+				e := other.Append(1)
+				l.Append(2)
+				if l.InsertBefore(3, e) != nil || l.InsertAfter(3, e) != nil {
+					t.Errorf("expected insert with foreign element to fail")
+				}
+				l.MoveToFront(e)
+				if _, ok := l.RemoveElement(e); ok {
+					t.Errorf("expected foreign element to not be removed")
+				}
+				if other.Size() != 1 {
+					t.Errorf("expected other list to be untouched")
+				}
+			},
+			want: []int{2},
+		},
+		{
+			name: "cleared element",
+			ops: func(l *LinkedList[int]) {
+				e := l.Append(1)
+				l.Clear()
+				l.Append(2)
+				if l.InsertAfter(3, e) != nil {
+					t.Errorf("expected insert with cleared element to fail")
+				}
+			},
+			want: []int{2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewLinkedList[int]()
+			tt.ops(&l)
+
+			if got := l.Items(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Items() got %v, want %v", got, tt.want)
+			}
+			if l.Size() != len(tt.want) {
+				t.Errorf("Size() got %d, want %d", l.Size(), len(tt.want))
+			}
+		})
+	}
+}
+
+func TestElement_FromSeq(t *testing.T) {
+	l := FromSeq(slices.Values([]string{"a", "b", "c"}))
+
+	e := l.Find("b")
+	if e == nil || e.Value != "b" {
+		t.Fatalf("Find() got %v, want b", e)
+	}
+	if l.Find("x") != nil {
+		t.Errorf("expected x to not be found")
+	}
+
+	l.MoveToBack(e)
+	l.InsertAfter("d", l.Front())
+
+	if got := l.Items(); !reflect.DeepEqual(got, []string{"a", "d", "c", "b"}) {
+		t.Errorf("got %v, want %v", got, []string{"a", "d", "c", "b"})
+	}
+
+	var forward []string
+	for c := l.Front(); c != nil; c = c.Next() {
+		forward = append(forward, c.Value)
+	}
+	var backward []string
+	for c := l.Back(); c != nil; c = c.Prev() {
+		backward = append(backward, c.Value)
+	}
+	slices.Reverse(backward)
+	if !reflect.DeepEqual(forward, backward) || !reflect.DeepEqual(forward, l.Items()) {
+		t.Errorf("Next() got %v, Prev() got %v", forward, backward)
+	}
+}
+
+func TestElement_LRU(t *testing.T) {
+	type entry struct {
+		key   string
+		value int
+	}
+	const capacity = 2
+
+	l := NewLinkedList[entry]()
+	index := map[string]*Element[entry]{}
+
+	put := func(k string, v int) {
+		if e, ok := index[k]; ok {
+			l.RemoveElement(e)
+		}
+		index[k] = l.Prepend(entry{key: k, value: v})
+		if l.Size() > capacity {
+			if old, ok := l.RemoveElement(l.Back()); ok {
+				delete(index, old.key)
+			}
+		}
+	}
+	get := func(k string) (int, bool) {
+		e, ok := index[k]
+		if !ok {
+			return 0, false
+		}
+		l.MoveToFront(e)
+		return e.Value.value, true
+	}
+
+	put("a", 1)
+	put("b", 2)
+	get("a")
+	put("c", 3)
+
+	if _, ok := get("b"); ok {
+		t.Errorf("expected b to be evicted")
+	}
+	if v, ok := get("a"); v != 1 || !ok {
+		t.Errorf("get(a) got %d (%v), want 1 (true)", v, ok)
+	}
+	if v, ok := get("c"); v != 3 || !ok {
+		t.Errorf("get(c) got %d (%v), want 3 (true)", v, ok)
+	}
+}
+
+func TestElement_NavigateWhileRemoving(t *testing.T) {
+	l := NewLinkedList[int]()
+	elements := make([]*Element[int], 0, 100)
+	for i := range 100 {
+		elements = append(elements, l.Append(i))
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for _, e := range elements {
+			e.Next()
+			e.Prev()
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for _, e := range elements {
+			l.RemoveElement(e)
+		}
+	}()
+	wg.Wait()
+
+	if l.Size() != 0 {
+		t.Errorf("expected all elements to be removed, got %d", l.Size())
+	}
+	for _, e := range elements {
+		if e.Next() != nil || e.Prev() != nil {
+			t.Errorf("expected removed element %d to have no neighbours", e.Value)
+		}
+	}
+}
@@ -4,14 +4,20 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"iter"
+	"slices"
 	"strings"
 	"sync"
 
-	"github.com/johannessarpola/gollections/internal/node"
+	"github.com/johannessarpola/gollections/internal/seqs"
 )
 
+// LinkedList is a doubly linked list which keeps track of its tail and size,
+// so operations on both ends are O(1).
 type LinkedList[T comparable] struct {
-	head *node.Node[T]
+	head *Element[T]
+	tail *Element[T]
+	size int
 	mu   sync.Mutex
 }
 
@@ -22,6 +28,35 @@ func NewLinkedList[T comparable]() LinkedList[T] {
 	}
 }
 
+// chain links the values into elements and returns the first and last element and the count.
+func chain[T comparable](seq iter.Seq[T]) (*Element[T], *Element[T], int) {
+	var (
+		head, tail *Element[T]
+		size       int
+	)
+	for v := range seq {
+		n := Element[T]{Value: v}
+		if head == nil {
+			head = &n
+		} else {
+			tail.next, n.prev = &n, tail
+		}
+		tail = &n
+		size++
+	}
+	return head, tail, size
+}
+
+// FromSeq creates a new list with the values of seq in order.
+func FromSeq[T comparable](seq iter.Seq[T]) LinkedList[T] {
+	head, tail, size := chain(seq)
+	return LinkedList[T]{
+		head: head,
+		tail: tail,
+		size: size,
+	}
+}
+
 func (l *LinkedList[T]) withLock(f func()) {
 	defer l.mu.Unlock()
 	l.mu.Lock()
@@ -32,7 +67,7 @@ func (l *LinkedList[T]) String() string {
 	sb := strings.Builder{}
 	var s []string
 	sb.WriteString("[")
-	for _, v := range l.All {
+	for v := range l.Values() {
 		s = append(s, fmt.Sprintf("%v", v))
 	}
 	sb.WriteString(strings.Join(s, ","))
@@ -40,127 +75,211 @@ func (l *LinkedList[T]) String() string {
 	return sb.String()
 }
 
-func (l *LinkedList[T]) Contains(value T) bool {
-	b := false
-	l.withLock(func() {
-		for current := l.head; current != nil; current = current.Next {
-			if current.Inner == value {
-				b = true
-				return
-			}
-		}
-	})
-	return b
+// pushBack links n after the tail, the lock must be held.
+func (l *LinkedList[T]) pushBack(n *Element[T]) {
+	l.lazyBind()
+	n.prev, n.next = l.tail, nil
+	n.list.Store(l)
+	if l.tail == nil {
+		l.head = n
+	} else {
+		l.tail.next = n
+	}
+	l.tail = n
+	l.size++
 }
 
-func (l *LinkedList[T]) Append(value T) {
-	n := node.NewNode(value)
-	l.withLock(func() {
-		if l.head == nil {
-			l.head = &n
-			return
-		}
+// pushFront links n before the head, the lock must be held.
+func (l *LinkedList[T]) pushFront(n *Element[T]) {
+	l.lazyBind()
+	n.prev, n.next = nil, l.head
+	n.list.Store(l)
+	if l.head == nil {
+		l.tail = n
+	} else {
+		l.head.prev = n
+	}
+	l.head = n
+	l.size++
+}
 
+// insertBefore links n before mark, the lock must be held.
+func (l *LinkedList[T]) insertBefore(n *Element[T], mark *Element[T]) {
+	if mark == l.head {
+		l.pushFront(n)
+		return
+	}
+	l.lazyBind()
+	n.prev, n.next = mark.prev, mark
+	n.list.Store(l)
+	mark.prev.next = n
+	mark.prev = n
+	l.size++
+}
+
+// link appends a chain of elements after the tail, the lock must be held.
+func (l *LinkedList[T]) link(head, tail *Element[T], size int) {
+	if head == nil {
+		return
+	}
+	l.lazyBind()
+	for current := head; current != nil; current = current.next {
+		current.list.Store(l)
+	}
+	if l.tail == nil {
+		l.head = head
+	} else {
+		l.tail.next, head.prev = head, l.tail
+	}
+	l.tail = tail
+	l.size += size
+}
+
+// unlink detaches n from the list, the lock must be held.
+func (l *LinkedList[T]) unlink(n *Element[T]) {
+	if n.prev == nil {
+		l.head = n.next
+	} else {
+		n.prev.next = n.next
+	}
+	if n.next == nil {
+		l.tail = n.prev
+	} else {
+		n.next.prev = n.prev
+	}
+	n.prev, n.next = nil, nil
+	n.list.Store(nil)
+	l.size--
+}
+
+// nodeAt walks to the element at index from the nearer end, the lock must be held.
+func (l *LinkedList[T]) nodeAt(index int) *Element[T] {
+	if index < 0 || index >= l.size {
+		return nil
+	}
+
+	if index < l.size/2 {
 		current := l.head
-		for current.Next != nil {
-			current = current.Next
+		for range index {
+			current = current.next
 		}
-		current.Next = &n
-	})
-}
+		return current
+	}
 
-func join[T comparable](root *node.Node[T], another *node.Node[T]) *node.Node[T] {
-	if root == nil {
-		return another
+	current := l.tail
+	for range l.size - 1 - index {
+		current = current.prev
 	}
+	return current
+}
 
-	last := root
-	for current := root; current != nil; current = current.Next {
-		last = current
+// find returns the first element holding value, the lock must be held.
+func (l *LinkedList[T]) find(value T) *Element[T] {
+	for current := l.head; current != nil; current = current.next {
+		if current.Value == value {
+			return current
+		}
 	}
+	return nil
+}
 
-	last.Next = another
-	return root
+func (l *LinkedList[T]) Contains(value T) bool {
+	b := false
+	l.withLock(func() {
+		b = l.find(value) != nil
+	})
+	return b
 }
 
+// Append adds value to the end of the list and returns its element.
+func (l *LinkedList[T]) Append(value T) *Element[T] {
+	n := &Element[T]{Value: value}
+	l.withLock(func() {
+		l.pushBack(n)
+	})
+	return n
+}
+
+// Join appends the values of another list to the end of this list.
 func (l *LinkedList[T]) Join(another *LinkedList[T]) *LinkedList[T] {
+	// the values are copied so the lists do not share elements
+	head, tail, size := chain(another.Values())
 	l.withLock(func() {
-		l.head = join(l.head, another.head)
+		l.link(head, tail, size)
 	})
 
 	return l
 }
 
 func (l *LinkedList[T]) InsertAt(index int, value T) error {
-	n := node.NewNode(value)
+	n := &Element[T]{Value: value}
 	var err error
 	l.withLock(func() {
-		if index == 0 {
-			n.Next, l.head = l.head, &n
-		} else {
-			current, next := l.head, l.head
-			for index > 0 {
-				current, next, index = next, next.Next, index-1
-				if next == nil { // Handle index out of bounds
-					err = errors.New("index out of bounds")
-					return
-				}
-			}
-
-			current.Next, n.Next = &n, next
+		switch {
+		case index < 0 || index > l.size: // Handle index out of bounds
+			err = errors.New("index out of bounds")
+		case index == l.size:
+			l.pushBack(n)
+		default:
+			l.insertBefore(n, l.nodeAt(index))
 		}
 	})
 	return err
 }
 
-func (l *LinkedList[T]) Prepend(value T) {
-	n := node.NewNode(value)
+// Prepend adds value to the start of the list and returns its element.
+func (l *LinkedList[T]) Prepend(value T) *Element[T] {
+	n := &Element[T]{Value: value}
 	l.withLock(func() {
-		n.Next = l.head
-		l.head = &n
+		l.pushFront(n)
 	})
+	return n
 }
 
 func (l *LinkedList[T]) IsEmpty() bool {
-	return l.head == nil
+	return l.Size() == 0
 }
 
-func (l *LinkedList[T]) All(yield func(int, T) bool) {
-	i := 0
+// snapshot copies the values from head to tail while holding the lock.
+func (l *LinkedList[T]) snapshot() []T {
+	var values []T
 	l.withLock(func() {
-		for current := l.head; current != nil; current = current.Next {
-			if !yield(i, current.Inner) {
-				break
-			}
-			i++
+		values = make([]T, 0, l.size)
+		for current := l.head; current != nil; current = current.next {
+			values = append(values, current.Value)
 		}
 	})
+	return values
+}
+
+// All iterates the values with their indexes from head to tail.
+func (l *LinkedList[T]) All() iter.Seq2[int, T] {
+	return seqs.Snapshot(l.snapshot)
+}
+
+// Backward iterates the values with their indexes from tail to head.
+func (l *LinkedList[T]) Backward() iter.Seq2[int, T] {
+	return seqs.Backward(l.snapshot)
+}
+
+// Values iterates the values from head to tail.
+func (l *LinkedList[T]) Values() iter.Seq[T] {
+	return seqs.Values(l.All())
 }
 
 func (l *LinkedList[T]) Size() int {
 	i := 0
 	l.withLock(func() {
-		current := l.head
-		if current != nil {
-			i++
-			for current.Next != nil {
-				current = current.Next
-				i++
-			}
-		}
+		i = l.size
 	})
-
 	return i
 }
 
 func (l *LinkedList[T]) IndexOf(value T) int {
-	if l.head == nil {
-		return -1
-	}
 	index, i := -1, 0
 	l.withLock(func() {
-		for current := l.head; current != nil; current = current.Next {
-			if current.Inner == value {
+		for current := l.head; current != nil; current = current.next {
+			if current.Value == value {
 				index = i
 				return
 			}
@@ -177,17 +296,11 @@ func (l *LinkedList[T]) GetLast() (T, bool) {
 		b bool
 	)
 
-	if l.head == nil {
-		return v, b
-	}
-
 	l.withLock(func() {
-		current := l.head
-		for current.Next != nil {
-			current = current.Next
+		if l.tail != nil {
+			v = l.tail.Value
+			b = true
 		}
-		v = current.Inner
-		b = true
 	})
 	return v, b
 }
@@ -200,7 +313,7 @@ func (l *LinkedList[T]) GetFirst() (T, bool) {
 
 	l.withLock(func() {
 		if l.head != nil {
-			v = l.head.Inner
+			v = l.head.Value
 			b = true
 		}
 	})
@@ -215,15 +328,12 @@ func (l *LinkedList[T]) GetAt(index int) (T, error) {
 	)
 
 	l.withLock(func() {
-		i := 0
-		for current := l.head; current != nil; current = current.Next {
-			if i == index {
-				v = current.Inner
-				return
-			}
-			i++
+		n := l.nodeAt(index)
+		if n == nil {
+			err = errors.New("index out of range")
+			return
 		}
-		err = errors.New("index out of range")
+		v = n.Value
 	})
 
 	return v, err
@@ -236,14 +346,11 @@ func (l *LinkedList[T]) RemoveLast() (T, bool) {
 	)
 
 	l.withLock(func() {
-		prev, current := l.head, l.head
-		for current.Next != nil {
-			prev = current
-			current = current.Next
+		if current := l.tail; current != nil {
+			v = current.Value
+			b = true
+			l.unlink(current)
 		}
-		prev.Next = nil
-		v = current.Inner
-		b = true
 	})
 
 	return v, b
@@ -255,11 +362,10 @@ func (l *LinkedList[T]) RemoveFirst() (T, bool) {
 		b bool
 	)
 	l.withLock(func() {
-		current := l.head
-		if current != nil {
-			v = current.Inner
+		if current := l.head; current != nil {
+			v = current.Value
 			b = true
-			l.head = current.Next
+			l.unlink(current)
 		}
 	})
 	return v, b
@@ -269,23 +375,9 @@ func (l *LinkedList[T]) Remove(val T) bool {
 	b := false
 
 	l.withLock(func() {
-		if l.head != nil {
-
-			if l.head.Inner == val {
-				l.head = l.head.Next
-				b = true
-				return
-			}
-
-			prev := l.head
-			for current := l.head; current != nil; current = current.Next {
-				if current.Inner == val {
-					b = true
-					prev.Next = current.Next
-					return
-				}
-				prev = current
-			}
+		if n := l.find(val); n != nil {
+			l.unlink(n)
+			b = true
 		}
 	})
 	return b
@@ -295,30 +387,16 @@ func (l *LinkedList[T]) RemoveAt(idx int) (T, error) {
 	var (
 		v   T
 		err error
-		b   bool
 	)
 
-	if idx == 0 {
-		v, b = l.RemoveFirst()
-		if !b {
-			err = errors.New("index out of bounds")
-		}
-		return v, err
-	}
-
 	l.withLock(func() {
-		i := 0
-		prev := l.head
-		for current := l.head; current != nil; current = current.Next {
-			if i == idx {
-				v = current.Inner
-				prev.Next = current.Next
-				return
-			}
-			prev = current
-			i++
+		n := l.nodeAt(idx)
+		if n == nil {
+			err = errors.New("index out of bounds")
+			return
 		}
-		err = errors.New("index out of bounds")
+		v = n.Value
+		l.unlink(n)
 	})
 
 	return v, err
@@ -326,35 +404,30 @@ func (l *LinkedList[T]) RemoveAt(idx int) (T, error) {
 
 func (l *LinkedList[T]) Clear() {
 	l.withLock(func() {
-		// this should detach head and trigger GC at some point
-		l.head = nil
+		// detach the elements so stale handles are no longer accepted
+		for current := l.head; current != nil; {
+			next := current.next
+			current.prev, current.next = nil, nil
+			current.list.Store(nil)
+			current = next
+		}
+		l.head, l.tail, l.size = nil, nil, 0
 	})
 }
 
 func (l *LinkedList[T]) Items() []T {
 	var rs []T
-	for _, s := range l.All {
+	for s := range l.Values() {
 		rs = append(rs, s)
 	}
 	return rs
 }
 
 func (l *LinkedList[T]) AddAll(items ...T) *LinkedList[T] {
-	sl := NewLinkedList[T]()
-	cur := sl.head
-	if cur == nil && len(items) > 0 {
-		f := node.NewNode(items[0])
-		sl.head = &f
-		cur = sl.head
-		items = items[1:]
-	}
-
-	for _, item := range items {
-		n := node.NewNode(item)
-		cur.Next = &n
-		cur = &n
-	}
-	l.Join(&sl)
+	head, tail, size := chain(slices.Values(items))
+	l.withLock(func() {
+		l.link(head, tail, size)
+	})
 	return l
 }
 
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"slices"
 	"testing"
 )
 
@@ -61,7 +62,7 @@ func TestLinkedList1(t *testing.T) {
 
 	count := 0
 	size := l.Size()
-	for range l.All {
+	for range l.All() {
 		count++
 	}
 
@@ -258,3 +259,189 @@ func TestLinkedList_UnmarshalJSON(t *testing.T) {
 		t.Errorf("expected strings to be contained")
 	}
 }
+
+func TestLinkedList_Iterators(t *testing.T) {
+	l := FromSeq(slices.Values([]int{1, 2, 3, 4}))
+
+	if got := slices.Collect(l.Values()); !reflect.DeepEqual(got, []int{1, 2, 3, 4}) {
+		t.Errorf("Values() got %v, want %v", got, []int{1, 2, 3, 4})
+	}
+
+	for i, v := range l.All() {
+		if v != i+1 {
+			t.Errorf("All() got %d at index %d, want %d", v, i, i+1)
+		}
+	}
+
+	var firstTwo []int
+	for v := range l.Values() {
+		if len(firstTwo) == 2 {
+			break
+		}
+		firstTwo = append(firstTwo, v)
+	}
+	if !reflect.DeepEqual(firstTwo, []int{1, 2}) {
+		t.Errorf("got %v, want %v", firstTwo, []int{1, 2})
+	}
+
+	empty := FromSeq(slices.Values([]string{}))
+	if !empty.IsEmpty() {
+		t.Errorf("expected list from empty seq to be empty")
+	}
+}
+
+func TestLinkedList_ModifyWhileIterating(t *testing.T) {
+	l := FromSeq(slices.Values([]int{1, 2, 3}))
+
+	for i, v := range l.All() {
+		if i == 0 && !l.Contains(v) {
+			t.Errorf("expected %d to be contained", v)
+		}
+		l.Append(v * 10)
+		if i == 0 {
+			l.Remove(3)
+		}
+	}
+
+	// the iteration runs over the values present when it started
+	if got := l.Items(); !reflect.DeepEqual(got, []int{1, 2, 10, 20, 30}) {
+		t.Errorf("got %v, want %v", got, []int{1, 2, 10, 20, 30})
+	}
+}
+
+func TestLinkedList_Backward(t *testing.T) {
+	l := FromSeq(slices.Values([]int{1, 2, 3}))
+
+	var (
+		indexes []int
+		values  []int
+	)
+	for i, v := range l.Backward() {
+		indexes = append(indexes, i)
+		values = append(values, v)
+	}
+	if !reflect.DeepEqual(indexes, []int{2, 1, 0}) {
+		t.Errorf("Backward() indexes got %v, want %v", indexes, []int{2, 1, 0})
+	}
+	if !reflect.DeepEqual(values, []int{3, 2, 1}) {
+		t.Errorf("Backward() values got %v, want %v", values, []int{3, 2, 1})
+	}
+}
+
+func TestLinkedList_TailOperations(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		ops      func(l *LinkedList[int])
+		want     []int
+		wantLast int
+		lastOk   bool
+	}{
+		{
+			name:  "remove last of empty",
+			input: nil,
+			ops: func(l *LinkedList[int]) {
+				if _, ok := l.RemoveLast(); ok {
+					t.Errorf("expected RemoveLast on empty list to fail")
+				}
+			},
+			want: nil,
+		},
+		{
+			name:  "remove last of single",
+			input: []int{1},
+			ops: func(l *LinkedList[int]) {
+				if v, ok := l.RemoveLast(); v != 1 || !ok {
+					t.Errorf("RemoveLast() got %d (%v), want 1 (true)", v, ok)
+				}
+			},
+			want: nil,
+		},
+		{
+			name:  "append after removing last",
+			input: []int{1, 2},
+			ops: func(l *LinkedList[int]) {
+				l.RemoveLast()
+				l.Append(3)
+			},
+			want:     []int{1, 3},
+			wantLast: 3,
+			lastOk:   true,
+		},
+		{
+			name:  "insert at size",
+			input: []int{1, 2},
+			ops: func(l *LinkedList[int]) {
+				if err := l.InsertAt(2, 3); err != nil {
+					t.Error(err)
+				}
+			},
+			want:     []int{1, 2, 3},
+			wantLast: 3,
+			lastOk:   true,
+		},
+		{
+			name:  "remove tail value",
+			input: []int{1, 2, 3},
+			ops: func(l *LinkedList[int]) {
+				l.Remove(3)
+				l.RemoveAt(0)
+			},
+			want:     []int{2},
+			wantLast: 2,
+			lastOk:   true,
+		},
+		{
+			name:  "prepend to empty",
+			input: nil,
+			ops: func(l *LinkedList[int]) {
+				l.Prepend(1)
+			},
+			want:     []int{1},
+			wantLast: 1,
+			lastOk:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := FromSeq(slices.Values(tt.input))
+			tt.ops(&l)
+
+			if got := l.Items(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Items() got %v, want %v", got, tt.want)
+			}
+			if l.Size() != len(tt.want) {
+				t.Errorf("Size() got %d, want %d", l.Size(), len(tt.want))
+			}
+			if v, ok := l.GetLast(); v != tt.wantLast || ok != tt.lastOk {
+				t.Errorf("GetLast() got %d (%v), want %d (%v)", v, ok, tt.wantLast, tt.lastOk)
+			}
+			if got := slices.Collect(l.Values()); len(got) > 0 {
+				var back []int
+				for _, v := range l.Backward() {
+					back = append(back, v)
+				}
+				slices.Reverse(back)
+				if !reflect.DeepEqual(back, got) {
+					t.Errorf("Backward() got %v, want reverse of %v", back, got)
+				}
+			}
+		})
+	}
+}
+
+func TestLinkedList_JoinCopies(t *testing.T) {
+	a := FromSeq(slices.Values([]int{1, 2}))
+	b := FromSeq(slices.Values([]int{3, 4}))
+
+	a.Join(&b)
+	b.Append(5)
+	a.Append(6)
+
+	if got := a.Items(); !reflect.DeepEqual(got, []int{1, 2, 3, 4, 6}) {
+		t.Errorf("got %v, want %v", got, []int{1, 2, 3, 4, 6})
+	}
+	if got := b.Items(); !reflect.DeepEqual(got, []int{3, 4, 5}) {
+		t.Errorf("got %v, want %v", got, []int{3, 4, 5})
+	}
+}
@@ -0,0 +1,154 @@
+package linkedlist
+
+// The functions in this file walk the element chain while holding the lock of the list,
+// so the given functions must not use the list they are called for.
+
+// Map returns a new list with f applied to each value of l.
+func Map[T, U comparable](l *LinkedList[T], f func(T) U) LinkedList[U] {
+	var (
+		head, tail *Element[U]
+		size       int
+	)
+	l.withLock(func() {
+		for current := l.head; current != nil; current = current.next {
+			n := &Element[U]{Value: f(current.Value), prev: tail}
+			if head == nil {
+				head = n
+			} else {
+				tail.next = n
+			}
+			tail = n
+			size++
+		}
+	})
+
+	return LinkedList[U]{
+		head: head,
+		tail: tail,
+		size: size,
+	}
+}
+
+// Filter returns a new list with the values of l for which keep returns true.
+func Filter[T comparable](l *LinkedList[T], keep func(T) bool) LinkedList[T] {
+	var (
+		head, tail *Element[T]
+		size       int
+	)
+	l.withLock(func() {
+		for current := l.head; current != nil; current = current.next {
+			if !keep(current.Value) {
+				continue
+			}
+			n := &Element[T]{Value: current.Value, prev: tail}
+			if head == nil {
+				head = n
+			} else {
+				tail.next = n
+			}
+			tail = n
+			size++
+		}
+	})
+
+	return LinkedList[T]{
+		head: head,
+		tail: tail,
+		size: size,
+	}
+}
+
+// Reduce folds the values of l from head to tail into a single value starting from initial.
+func Reduce[T comparable, U any](l *LinkedList[T], initial U, f func(U, T) U) U {
+	acc := initial
+	l.withLock(func() {
+		for current := l.head; current != nil; current = current.next {
+			acc = f(acc, current.Value)
+		}
+	})
+	return acc
+}
+
+// Reverse reverses the order of the values of l in place.
+func Reverse[T comparable](l *LinkedList[T]) {
+	l.withLock(func() {
+		for current := l.head; current != nil; current = current.prev {
+			current.next, current.prev = current.prev, current.next
+		}
+		l.head, l.tail = l.tail, l.head
+	})
+}
+
+// Sort sorts l in place with a stable merge sort, compare returns a negative number
+// when a < b, a positive number when a > b and zero when they are equal.
+func Sort[T comparable](l *LinkedList[T], compare func(a, b T) int) {
+	l.withLock(func() {
+		l.head = mergeSort(l.head, l.size, compare)
+
+		// only the next links are kept by the merge so the prev links are restored here
+		var prev *Element[T]
+		for current := l.head; current != nil; current = current.next {
+			current.prev = prev
+			prev = current
+		}
+		l.tail = prev
+	})
+}
+
+// mergeSort sorts the first n elements of the chain starting from head by their next links.
+func mergeSort[T comparable](head *Element[T], n int, compare func(a, b T) int) *Element[T] {
+	if n < 2 {
+		if head != nil {
+			head.next = nil
+		}
+		return head
+	}
+
+	mid := head
+	for range n / 2 {
+		mid = mid.next
+	}
+
+	left := mergeSort(head, n/2, compare)
+	right := mergeSort(mid, n-n/2, compare)
+	return merge(left, right, compare)
+}
+
+// merge merges two sorted chains, on equal values the left one goes first to keep the sort stable.
+func merge[T comparable](left, right *Element[T], compare func(a, b T) int) *Element[T] {
+	var (
+		head Element[T]
+		tail = &head
+	)
+	for left != nil && right != nil {
+		if compare(left.Value, right.Value) <= 0 {
+			tail.next, left = left, left.next
+		} else {
+			tail.next, right = right, right.next
+		}
+		tail = tail.next
+	}
+	if left != nil {
+		tail.next = left
+	} else {
+		tail.next = right
+	}
+	return head.next
+}
+
+// Dedup removes in place the values of l which occurred earlier in the list,
+// so the first occurrence of each value is kept.
+func Dedup[T comparable](l *LinkedList[T]) {
+	l.withLock(func() {
+		seen := make(map[T]struct{}, l.size)
+		for current := l.head; current != nil; {
+			next := current.next
+			if _, ok := seen[current.Value]; ok {
+				l.unlink(current)
+			} else {
+				seen[current.Value] = struct{}{}
+			}
+			current = next
+		}
+	})
+}
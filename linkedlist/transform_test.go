@@ -0,0 +1,161 @@
+package linkedlist
+
+import (
+	"cmp"
+	"reflect"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestMapFilterReduce(t *testing.T) {
+	l := FromSeq(slices.Values([]int{1, 2, 3, 4}))
+
+	m := Map(&l, strconv.Itoa)
+	if got := m.Items(); !reflect.DeepEqual(got, []string{"1", "2", "3", "4"}) {
+		t.Errorf("Map() got %v", got)
+	}
+	if v, ok := m.GetLast(); v != "4" || !ok || m.Size() != 4 {
+		t.Errorf("Map() got last %v (%v) and size %d", v, ok, m.Size())
+	}
+
+	f := Filter(&l, func(v int) bool { return v%2 == 0 })
+	if got := f.Items(); !reflect.DeepEqual(got, []int{2, 4}) {
+		t.Errorf("Filter() got %v", got)
+	}
+	f.Append(6)
+	if got := slices.Collect(f.Values()); !reflect.DeepEqual(got, []int{2, 4, 6}) || f.Size() != 3 {
+		t.Errorf("Filter() list got %v with size %d after append", got, f.Size())
+	}
+
+	sum := Reduce(&l, 0, func(acc, v int) int { return acc + v })
+	if sum != 10 {
+		t.Errorf("Reduce() got %d, want 10", sum)
+	}
+	joined := Reduce(&l, "", func(acc string, v int) string { return acc + strconv.Itoa(v) })
+	if joined != "1234" {
+		t.Errorf("Reduce() got %s, want 1234", joined)
+	}
+
+	// the source list is left untouched
+	if got := l.Items(); !reflect.DeepEqual(got, []int{1, 2, 3, 4}) {
+		t.Errorf("got %v, want %v", got, []int{1, 2, 3, 4})
+	}
+}
+
+func TestReverse(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  []int
+	}{
+		{name: "empty", input: nil, want: nil},
+		{name: "single", input: []int{1}, want: []int{1}},
+		{name: "many", input: []int{1, 2, 3, 4}, want: []int{4, 3, 2, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := FromSeq(slices.Values(tt.input))
+			Reverse(&l)
+
+			if got := l.Items(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Reverse() got %v, want %v", got, tt.want)
+			}
+			var back []int
+			for _, v := range l.Backward() {
+				back = append(back, v)
+			}
+			slices.Reverse(back)
+			if !reflect.DeepEqual(back, tt.want) {
+				t.Errorf("Backward() after Reverse() got %v, want reverse of %v", back, tt.want)
+			}
+		})
+	}
+}
+
+func TestSort(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  []int
+	}{
+		{name: "empty", input: nil, want: nil},
+		{name: "single", input: []int{1}, want: []int{1}},
+		{name: "sorted", input: []int{1, 2, 3}, want: []int{1, 2, 3}},
+		{name: "reversed", input: []int{5, 4, 3, 2, 1}, want: []int{1, 2, 3, 4, 5}},
+		{name: "duplicates", input: []int{3, 1, 3, 2, 1}, want: []int{1, 1, 2, 3, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := FromSeq(slices.Values(tt.input))
+			Sort(&l, cmp.Compare[int])
+
+			if got := l.Items(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Sort() got %v, want %v", got, tt.want)
+			}
+			if l.Size() != len(tt.want) {
+				t.Errorf("Size() got %d, want %d", l.Size(), len(tt.want))
+			}
+			if len(tt.want) > 0 {
+				if v, _ := l.GetLast(); v != tt.want[len(tt.want)-1] {
+					t.Errorf("GetLast() got %d, want %d", v, tt.want[len(tt.want)-1])
+				}
+				if v, _ := l.RemoveLast(); v != tt.want[len(tt.want)-1] {
+					t.Errorf("RemoveLast() got %d, want %d", v, tt.want[len(tt.want)-1])
+				}
+			}
+		})
+	}
+}
+
+func TestSort_Stable(t *testing.T) {
+	type person struct {
+		name string
+		age  int
+	}
+	l := FromSeq(slices.Values([]person{
+		{"a", 30}, {"b", 20}, {"c", 30}, {"d", 10}, {"e", 20},
+	}))
+	e := l.Find(person{"c", 30})
+
+	Sort(&l, func(a, b person) int { return cmp.Compare(a.age, b.age) })
+
+	var names string
+	for v := range l.Values() {
+		names += v.name
+	}
+	if names != "dbeac" {
+		t.Errorf("Sort() got %s, want dbeac", names)
+	}
+
+	// element handles stay valid after sorting
+	l.MoveToFront(e)
+	if v, _ := l.GetFirst(); v.name != "c" {
+		t.Errorf("GetFirst() got %v, want c", v)
+	}
+}
+
+func TestDedup(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []string
+		want  []string
+	}{
+		{name: "empty", input: nil, want: nil},
+		{name: "unique", input: []string{"a", "b"}, want: []string{"a", "b"}},
+		{name: "duplicates", input: []string{"a", "b", "a", "c", "b", "b"}, want: []string{"a", "b", "c"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := FromSeq(slices.Values(tt.input))
+			Dedup(&l)
+
+			if got := l.Items(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Dedup() got %v, want %v", got, tt.want)
+			}
+			if l.Size() != len(tt.want) {
+				t.Errorf("Size() got %d, want %d", l.Size(), len(tt.want))
+			}
+		})
+	}
+}
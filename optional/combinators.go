@@ -0,0 +1,85 @@
+package optional
+
+import (
+	"errors"
+
+	"github.com/johannessarpola/gollections/result"
+)
+
+// ErrNotPresent is the error of the result of ToResult for an empty optional when no error is given.
+var ErrNotPresent = errors.New("value is not present")
+
+// Pair holds the values of two optionals combined with Zip.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Map applies f to the value if it exists.
+func Map[T, U any](o Optional[T], f func(T) U) Optional[U] {
+	if o.Exist {
+		return NewExisting(f(o.Value))
+	}
+	return Empty[U]()
+}
+
+// FlatMap applies f returning an optional to the value if it exists.
+func FlatMap[T, U any](o Optional[T], f func(T) Optional[U]) Optional[U] {
+	if o.Exist {
+		return f(o.Value)
+	}
+	return Empty[U]()
+}
+
+// Zip combines two optionals into a pair, which exists only if both values exist.
+func Zip[A, B any](a Optional[A], b Optional[B]) Optional[Pair[A, B]] {
+	if a.Exist && b.Exist {
+		return NewExisting(Pair[A, B]{First: a.Value, Second: b.Value})
+	}
+	return Empty[Pair[A, B]]()
+}
+
+// ToResult converts the optional to a result, which has err when the value does not exist,
+// or ErrNotPresent if err is nil.
+func ToResult[T any](o Optional[T], err error) result.Result[T] {
+	if o.Exist {
+		return result.NewOk(o.Value)
+	}
+	if err == nil {
+		err = ErrNotPresent
+	}
+	return result.NewErr[T](err)
+}
+
+// Filter returns the optional if the value exists and matches predicate, otherwise an empty optional.
+func (o Optional[T]) Filter(predicate func(T) bool) Optional[T] {
+	if o.Exist && predicate(o.Value) {
+		return o
+	}
+	return Empty[T]()
+}
+
+// Or returns the optional if the value exists, otherwise the alternative.
+func (o Optional[T]) Or(alternative Optional[T]) Optional[T] {
+	if o.Exist {
+		return o
+	}
+	return alternative
+}
+
+// OrElseGet returns the value if it exists, otherwise the value computed by f.
+func (o Optional[T]) OrElseGet(f func() T) T {
+	if o.Exist {
+		return o.Value
+	}
+	return f()
+}
+
+// IfPresentOrElse calls f with the value if it exists, otherwise calls orElse.
+func (o Optional[T]) IfPresentOrElse(f func(T), orElse func()) {
+	if o.Exist {
+		f(o.Value)
+	} else {
+		orElse()
+	}
+}
@@ -0,0 +1,298 @@
+package optional
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  Optional[int]
+		want Optional[string]
+	}{
+		{
+			name: "value exists, map it",
+			opt:  NewExisting(42),
+			want: NewExisting("42"),
+		},
+		{
+			name: "value does not exist, stay empty",
+			opt:  Empty[int](),
+			want: Empty[string](),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Map(tt.opt, strconv.Itoa); got != tt.want {
+				t.Errorf("Map() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	parse := func(s string) Optional[int] {
+		i, err := strconv.Atoi(s)
+		return New(i, err == nil)
+	}
+
+	tests := []struct {
+		name string
+		opt  Optional[string]
+		want Optional[int]
+	}{
+		{
+			name: "value exists and maps",
+			opt:  NewExisting("7"),
+			want: NewExisting(7),
+		},
+		{
+			name: "value exists but maps to empty",
+			opt:  NewExisting("seven"),
+			want: Empty[int](),
+		},
+		{
+			name: "value does not exist",
+			opt:  Empty[string](),
+			want: Empty[int](),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FlatMap(tt.opt, parse); got.Exist != tt.want.Exist || got.Value != tt.want.Value {
+				t.Errorf("FlatMap() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+
+	tests := []struct {
+		name string
+		opt  Optional[int]
+		want Optional[int]
+	}{
+		{
+			name: "value matches",
+			opt:  NewExisting(2),
+			want: NewExisting(2),
+		},
+		{
+			name: "value does not match",
+			opt:  NewExisting(3),
+			want: Empty[int](),
+		},
+		{
+			name: "value does not exist",
+			opt:  Empty[int](),
+			want: Empty[int](),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.opt.Filter(even); got != tt.want {
+				t.Errorf("Filter() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOr(t *testing.T) {
+	tests := []struct {
+		name        string
+		opt         Optional[int]
+		alternative Optional[int]
+		want        Optional[int]
+	}{
+		{
+			name:        "value exists, keep it",
+			opt:         NewExisting(1),
+			alternative: NewExisting(2),
+			want:        NewExisting(1),
+		},
+		{
+			name:        "value does not exist, use alternative",
+			opt:         Empty[int](),
+			alternative: NewExisting(2),
+			want:        NewExisting(2),
+		},
+		{
+			name:        "neither exists",
+			opt:         Empty[int](),
+			alternative: Empty[int](),
+			want:        Empty[int](),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.opt.Or(tt.alternative); got != tt.want {
+				t.Errorf("Or() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrElseGet(t *testing.T) {
+	tests := []struct {
+		name       string
+		opt        Optional[int]
+		want       int
+		wantCalled bool
+	}{
+		{
+			name:       "value exists, fallback not called",
+			opt:        NewExisting(10),
+			want:       10,
+			wantCalled: false,
+		},
+		{
+			name:       "value does not exist, fallback called",
+			opt:        Empty[int](),
+			want:       42,
+			wantCalled: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			got := tt.opt.OrElseGet(func() int {
+				called = true
+				return 42
+			})
+			if got != tt.want || called != tt.wantCalled {
+				t.Errorf("OrElseGet() = %v (called %v), want %v (called %v)", got, called, tt.want, tt.wantCalled)
+			}
+		})
+	}
+}
+
+func TestIfPresentOrElse(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  Optional[string]
+		want string
+	}{
+		{
+			name: "value exists",
+			opt:  NewExisting("value"),
+			want: "present:value",
+		},
+		{
+			name: "value does not exist",
+			opt:  Empty[string](),
+			want: "absent",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			tt.opt.IfPresentOrElse(
+				func(v string) { got = "present:" + v },
+				func() { got = "absent" },
+			)
+			if got != tt.want {
+				t.Errorf("IfPresentOrElse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestZip(t *testing.T) {
+	tests := []struct {
+		name string
+		a    Optional[string]
+		b    Optional[int]
+		want Optional[Pair[string, int]]
+	}{
+		{
+			name: "both exist",
+			a:    NewExisting("a"),
+			b:    NewExisting(1),
+			want: NewExisting(Pair[string, int]{First: "a", Second: 1}),
+		},
+		{
+			name: "first does not exist",
+			a:    Empty[string](),
+			b:    NewExisting(1),
+			want: Empty[Pair[string, int]](),
+		},
+		{
+			name: "second does not exist",
+			a:    NewExisting("a"),
+			b:    Empty[int](),
+			want: Empty[Pair[string, int]](),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Zip(tt.a, tt.b); got != tt.want {
+				t.Errorf("Zip() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToResult(t *testing.T) {
+	errMissing := errors.New("missing")
+
+	tests := []struct {
+		name    string
+		opt     Optional[int]
+		err     error
+		want    int
+		wantErr error
+	}{
+		{
+			name:    "value exists, ok result",
+			opt:     NewExisting(5),
+			err:     errMissing,
+			want:    5,
+			wantErr: nil,
+		},
+		{
+			name:    "value does not exist, error result",
+			opt:     Empty[int](),
+			err:     errMissing,
+			want:    0,
+			wantErr: errMissing,
+		},
+		{
+			name:    "value does not exist without error, not present result",
+			opt:     Empty[int](),
+			err:     nil,
+			want:    0,
+			wantErr: ErrNotPresent,
+		},
+		{
+			name:    "zero value exists without error, ok result",
+			opt:     NewExisting(0),
+			err:     nil,
+			want:    0,
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := ToResult(tt.opt, tt.err)
+			got, err := r.Get()
+			if got != tt.want || !errors.Is(err, tt.wantErr) {
+				t.Errorf("ToResult() = %v, %v, want %v, %v", got, err, tt.want, tt.wantErr)
+			}
+			if r.OK() != (tt.wantErr == nil) {
+				t.Errorf("ToResult().OK() = %v, want %v", r.OK(), tt.wantErr == nil)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package optional
+
+import (
+	"encoding/json"
+)
+
+// Nullable represents a value which is either unset, explicitly null or set, for example
+// a field of a PATCH payload where a missing field and null mean different things.
+type Nullable[T any] struct {
+	Value   T
+	Present bool // the value was set, either to null or to a value
+	Valid   bool // the value is not null
+}
+
+// NewNullable creates a Nullable holding value.
+func NewNullable[T any](value T) Nullable[T] {
+	return Nullable[T]{Value: value, Present: true, Valid: true}
+}
+
+// Null creates a Nullable which is explicitly null.
+func Null[T any]() Nullable[T] {
+	return Nullable[T]{Present: true}
+}
+
+// Unset creates a Nullable which has not been set.
+func Unset[T any]() Nullable[T] {
+	return Nullable[T]{}
+}
+
+// IsSet returns true if the value was set, either to null or to a value.
+func (n Nullable[T]) IsSet() bool {
+	return n.Present
+}
+
+// IsNull returns true if the value was explicitly set to null.
+func (n Nullable[T]) IsNull() bool {
+	return n.Present && !n.Valid
+}
+
+// IsPresent returns true if the value was set to a value.
+func (n Nullable[T]) IsPresent() bool {
+	return n.Present && n.Valid
+}
+
+// Optional converts the value to an Optional, both unset and null become empty.
+func (n Nullable[T]) Optional() Optional[T] {
+	return New(n.Value, n.IsPresent())
+}
+
+// IsZero reports whether the value is unset, so fields tagged with omitzero are omitted
+// when unset while null is still written.
+func (n Nullable[T]) IsZero() bool {
+	return !n.Present
+}
+
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if !n.IsPresent() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Value)
+}
+
+// UnmarshalJSON is only called for fields present in the json, so the value is set
+// to either null or a value. Missing fields stay unset.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	var zero T
+	n.Value = zero
+	n.Present = true
+	n.Valid = false
+
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &n.Value); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
@@ -0,0 +1,101 @@
+package optional
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type patch struct {
+	Name Nullable[string] `json:"name,omitzero"`
+	Age  Nullable[int]    `json:"age,omitzero"`
+}
+
+func TestNullableStates(t *testing.T) {
+	tests := []struct {
+		name        string
+		n           Nullable[int]
+		wantSet     bool
+		wantNull    bool
+		wantPresent bool
+	}{
+		{name: "unset", n: Unset[int](), wantSet: false, wantNull: false, wantPresent: false},
+		{name: "null", n: Null[int](), wantSet: true, wantNull: true, wantPresent: false},
+		{name: "value", n: NewNullable(0), wantSet: true, wantNull: false, wantPresent: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.n.IsSet(); got != tt.wantSet {
+				t.Errorf("IsSet() = %v, want %v", got, tt.wantSet)
+			}
+			if got := tt.n.IsNull(); got != tt.wantNull {
+				t.Errorf("IsNull() = %v, want %v", got, tt.wantNull)
+			}
+			if got := tt.n.IsPresent(); got != tt.wantPresent {
+				t.Errorf("IsPresent() = %v, want %v", got, tt.wantPresent)
+			}
+			if got := tt.n.Optional().IsPresent(); got != tt.wantPresent {
+				t.Errorf("Optional().IsPresent() = %v, want %v", got, tt.wantPresent)
+			}
+		})
+	}
+}
+
+func TestNullableRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected patch
+	}{
+		{
+			"Field absent",
+			`{}`,
+			patch{Name: Unset[string](), Age: Unset[int]()},
+		},
+		{
+			"Field null",
+			`{"name":null}`,
+			patch{Name: Null[string](), Age: Unset[int]()},
+		},
+		{
+			"Field set",
+			`{"name":"Alice","age":0}`,
+			patch{Name: NewNullable("Alice"), Age: NewNullable(0)},
+		},
+		{
+			"Mixed",
+			`{"name":"Bob","age":null}`,
+			patch{Name: NewNullable("Bob"), Age: Null[int]()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result patch
+			if err := json.Unmarshal([]byte(tt.input), &result); err != nil {
+				t.Fatalf("Failed to unmarshal: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Expected %+v, got %+v", tt.expected, result)
+			}
+
+			data, err := json.Marshal(result)
+			if err != nil {
+				t.Fatalf("Failed to marshal: %v", err)
+			}
+			if string(data) != tt.input {
+				t.Errorf("Expected %s, got %s", tt.input, data)
+			}
+		})
+	}
+}
+
+func TestNullableUnmarshalError(t *testing.T) {
+	var n Nullable[int]
+	if err := json.Unmarshal([]byte(`"abc"`), &n); err == nil {
+		t.Errorf("expected error for invalid value")
+	}
+	if n.IsPresent() {
+		t.Errorf("expected invalid value to not be present")
+	}
+}
@@ -48,21 +48,32 @@ func (o Optional[T]) GetOrDefault(defaultValue T) T {
 	return defaultValue
 }
 
-func (o *Optional[T]) MarshalJSON() ([]byte, error) {
+// IsZero reports whether the value is absent, so fields tagged with omitzero are omitted when empty.
+func (o Optional[T]) IsZero() bool {
+	return !o.Exist
+}
+
+// MarshalJSON marshals the value, or null when it does not exist. It is declared on the
+// value receiver so it is also used for non-pointer struct fields.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
 	if !o.Exist {
-		return []byte("null"), nil // Omitting the field entirely
+		return []byte("null"), nil
 	}
 	return json.Marshal(o.Value)
 }
 
+// UnmarshalJSON treats null the same as a missing field, use Nullable to tell them apart.
 func (o *Optional[T]) UnmarshalJSON(data []byte) error {
 	if len(data) == 0 || string(data) == "null" {
-		o.Exist = true // Field was missing, set Exist = true
 		var zero T
 		o.Value = zero
+		o.Exist = false
 		return nil
 	}
 
+	if err := json.Unmarshal(data, &o.Value); err != nil {
+		return err
+	}
 	o.Exist = true
-	return json.Unmarshal(data, &o.Value)
+	return nil
 }
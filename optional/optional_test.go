@@ -210,7 +210,7 @@ func TestOptionalUnmarshalJSON(t *testing.T) {
 		{"Bool present", `true`, Optional[any]{Value: true, Exist: true}},
 		{"Zero int", `0`, Optional[any]{Value: float64(0), Exist: true}},
 		{"Empty string", `""`, Optional[any]{Value: "", Exist: true}},
-		{"Null (missing field equivalent)", `null`, Optional[any]{Value: nil, Exist: true}},
+		{"Null (missing field equivalent)", `null`, Optional[any]{Value: nil, Exist: false}},
 	}
 
 	for _, tt := range tests {
@@ -281,3 +281,52 @@ func TestParentStructUnmarshal(t *testing.T) {
 		})
 	}
 }
+
+func TestOptionalStructFieldRoundTrip(t *testing.T) {
+	type payload struct {
+		Name  Optional[string] `json:"name,omitzero"`
+		Age   Optional[int]    `json:"age"`
+		Email Optional[string] `json:"email,omitzero"`
+	}
+
+	tests := []struct {
+		name     string
+		input    payload
+		expected string
+		decoded  payload
+	}{
+		{
+			"Value fields",
+			payload{Name: NewExisting("Alice"), Age: NewExisting(0), Email: NewExisting("")},
+			`{"name":"Alice","age":0,"email":""}`,
+			payload{Name: NewExisting("Alice"), Age: NewExisting(0), Email: NewExisting("")},
+		},
+		{
+			"Empty fields are omitted or null",
+			payload{},
+			`{"age":null}`,
+			payload{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// marshalled as a value, not through a pointer
+			data, err := json.Marshal(tt.input)
+			if err != nil {
+				t.Fatalf("Failed to marshal: %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, data)
+			}
+
+			var result payload
+			if err := json.Unmarshal(data, &result); err != nil {
+				t.Fatalf("Failed to unmarshal: %v", err)
+			}
+			if result != tt.decoded {
+				t.Errorf("Expected %+v, got %+v", tt.decoded, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package optional
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+var _ sql.Scanner = (*Optional[string])(nil)
+
+// Scan implements sql.Scanner, NULL becomes an empty optional. The conversions are the ones
+// database/sql does for the common scalar types and time.Time.
+func (o *Optional[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+
+	o.Value, o.Exist = n.V, n.Valid
+	return nil
+}
+
+// Valuer returns the optional as a driver.Valuer to be used as a query argument, an empty
+// optional is written as NULL. Optional cannot implement driver.Valuer itself as its Value
+// field takes the name of the method.
+func (o Optional[T]) Valuer() driver.Valuer {
+	return sql.Null[T]{V: o.Value, Valid: o.Exist}
+}
@@ -0,0 +1,159 @@
+package optional
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// fakeDriver is an in-process driver which returns its rows for every query
+// and records the arguments of every exec.
+type fakeDriver struct {
+	columns []string
+	rows    [][]driver.Value
+	args    [][]driver.Value
+}
+
+type fakeConn struct{ d *fakeDriver }
+
+type fakeStmt struct{ d *fakeDriver }
+
+type fakeRows struct {
+	d *fakeDriver
+	i int
+}
+
+var fake = &fakeDriver{}
+
+func init() {
+	sql.Register("optional-fake", fake)
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) { return &fakeConn{d: d}, nil }
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) { return &fakeStmt{d: c.d}, nil }
+func (c *fakeConn) Close() error                        { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.d.args = append(s.d.args, args)
+	return driver.RowsAffected(1), nil
+}
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) { return &fakeRows{d: s.d}, nil }
+
+func (r *fakeRows) Columns() []string { return r.d.columns }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.i >= len(r.d.rows) {
+		return io.EOF
+	}
+	copy(dest, r.d.rows[r.i])
+	r.i++
+	return nil
+}
+
+type row struct {
+	Name    Optional[string]
+	Count   Optional[int]
+	Big     Optional[int64]
+	Ratio   Optional[float64]
+	Enabled Optional[bool]
+	Created Optional[time.Time]
+}
+
+func TestOptionalScan(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	fake.columns = []string{"name", "count", "big", "ratio", "enabled", "created"}
+	fake.rows = [][]driver.Value{
+		{"alice", int64(3), int64(1 << 40), 0.5, true, created},
+		{nil, nil, nil, nil, nil, nil},
+		{[]byte("bob"), "7", int64(0), "1.5", int64(0), created},
+	}
+
+	db, err := sql.Open("optional-fake", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	rows, err := db.Query("select")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var got []row
+	for rows.Next() {
+		var r row
+		if err := rows.Scan(&r.Name, &r.Count, &r.Big, &r.Ratio, &r.Enabled, &r.Created); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, r)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []row{
+		{
+			Name: NewExisting("alice"), Count: NewExisting(3), Big: NewExisting(int64(1 << 40)),
+			Ratio: NewExisting(0.5), Enabled: NewExisting(true), Created: NewExisting(created),
+		},
+		{},
+		{
+			Name: NewExisting("bob"), Count: NewExisting(7), Big: NewExisting(int64(0)),
+			Ratio: NewExisting(1.5), Enabled: NewExisting(false), Created: NewExisting(created),
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Scan() got %+v, want %+v", got, want)
+	}
+}
+
+func TestOptionalScanError(t *testing.T) {
+	o := NewExisting(1)
+	if err := o.Scan("abc"); err == nil {
+		t.Errorf("expected error when scanning text into an int")
+	}
+}
+
+func TestOptionalValuer(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		arg  driver.Valuer
+		want driver.Value
+	}{
+		{name: "string", arg: NewExisting("alice").Valuer(), want: "alice"},
+		{name: "int", arg: NewExisting(3).Valuer(), want: int64(3)},
+		{name: "float", arg: NewExisting(0.5).Valuer(), want: 0.5},
+		{name: "bool", arg: NewExisting(false).Valuer(), want: false},
+		{name: "time", arg: NewExisting(created).Valuer(), want: created},
+		{name: "empty", arg: Empty[string]().Valuer(), want: nil},
+	}
+
+	db, err := sql.Open("optional-fake", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake.args = nil
+			if _, err := db.Exec("insert", tt.arg); err != nil {
+				t.Fatal(err)
+			}
+			if len(fake.args) != 1 || !reflect.DeepEqual(fake.args[0], []driver.Value{tt.want}) {
+				t.Errorf("Exec() args got %v, want %v", fake.args, tt.want)
+			}
+		})
+	}
+}
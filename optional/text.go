@@ -0,0 +1,145 @@
+package optional
+
+import (
+	"encoding"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+var (
+	_ encoding.TextMarshaler   = Optional[string]{}
+	_ encoding.TextUnmarshaler = (*Optional[string])(nil)
+	_ xml.Marshaler            = Optional[string]{}
+	_ xml.Unmarshaler          = (*Optional[string])(nil)
+	_ xml.MarshalerAttr        = Optional[string]{}
+	_ xml.UnmarshalerAttr      = (*Optional[string])(nil)
+)
+
+// formatScalar formats the scalar kinds which have no text marshalling of their own.
+func formatScalar(v reflect.Value) ([]byte, error) {
+	switch v.Kind() {
+	case reflect.String:
+		return []byte(v.String()), nil
+	case reflect.Bool:
+		return strconv.AppendBool(nil, v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.AppendUint(nil, v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(nil, v.Float(), 'g', -1, v.Type().Bits()), nil
+	default:
+		return nil, fmt.Errorf("unsupported type: %s", v.Type())
+	}
+}
+
+// parseScalar parses text into the scalar kinds which have no text unmarshalling of their own.
+func parseScalar(text string, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(text)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(text, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(text, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(text, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type: %s", v.Type())
+	}
+	return nil
+}
+
+// MarshalText delegates to T when it implements encoding.TextMarshaler and formats scalars otherwise,
+// an empty optional is marshalled as empty text. A present value with empty text, such as an empty
+// string, is therefore indistinguishable from an empty optional and is read back as empty.
+func (o Optional[T]) MarshalText() ([]byte, error) {
+	if !o.Exist {
+		return []byte{}, nil
+	}
+	if m, ok := any(&o.Value).(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+	return formatScalar(reflect.ValueOf(&o.Value).Elem())
+}
+
+// UnmarshalText delegates to T when it implements encoding.TextUnmarshaler and parses scalars otherwise,
+// empty text is unmarshalled as an empty optional. A present empty string does not survive a round trip
+// through text, flags or xml attributes, it comes back as an empty optional.
+func (o *Optional[T]) UnmarshalText(text []byte) error {
+	var v T
+	if len(text) == 0 {
+		o.Value, o.Exist = v, false
+		return nil
+	}
+
+	var err error
+	if u, ok := any(&v).(encoding.TextUnmarshaler); ok {
+		err = u.UnmarshalText(text)
+	} else {
+		err = parseScalar(string(text), reflect.ValueOf(&v).Elem())
+	}
+	if err != nil {
+		return err
+	}
+
+	o.Value, o.Exist = v, true
+	return nil
+}
+
+// MarshalXML encodes the value as the element, an empty optional omits the element.
+func (o Optional[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !o.Exist {
+		return nil
+	}
+	return e.EncodeElement(&o.Value, start)
+}
+
+// UnmarshalXML decodes the element into the value, the optional exists when the element is present.
+func (o *Optional[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v T
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+
+	o.Value, o.Exist = v, true
+	return nil
+}
+
+// MarshalXMLAttr encodes the value as the text of the attribute, an empty optional omits the attribute.
+func (o Optional[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !o.Exist {
+		return xml.Attr{}, nil
+	}
+
+	text, err := o.MarshalText()
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+// UnmarshalXMLAttr decodes the text of the attribute into the value.
+func (o *Optional[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return o.UnmarshalText([]byte(attr.Value))
+}
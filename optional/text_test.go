@@ -0,0 +1,231 @@
+package optional
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"flag"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestOptionalTextRoundTrip(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		arg  any
+		text string
+		into func() any
+	}{
+		{name: "string", arg: NewExisting("alice"), text: "alice", into: func() any { return &Optional[string]{} }},
+		{name: "int", arg: NewExisting(-3), text: "-3", into: func() any { return &Optional[int]{} }},
+		{name: "uint8", arg: NewExisting(uint8(255)), text: "255", into: func() any { return &Optional[uint8]{} }},
+		{name: "float", arg: NewExisting(1.5), text: "1.5", into: func() any { return &Optional[float64]{} }},
+		{name: "bool", arg: NewExisting(false), text: "false", into: func() any { return &Optional[bool]{} }},
+		{name: "time", arg: NewExisting(created), text: "2024-01-02T03:04:05Z", into: func() any { return &Optional[time.Time]{} }},
+		{name: "pointer receiver", arg: NewExisting(*big.NewInt(-42)), text: "-42", into: func() any { return &Optional[big.Int]{} }},
+		{name: "empty", arg: Empty[int](), text: "", into: func() any { return &Optional[int]{} }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := tt.arg.(interface{ MarshalText() ([]byte, error) })
+			text, err := m.MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(text) != tt.text {
+				t.Errorf("MarshalText() got %q, want %q", text, tt.text)
+			}
+
+			got := tt.into()
+			if err := got.(interface{ UnmarshalText([]byte) error }).UnmarshalText(text); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(reflect.ValueOf(got).Elem().Interface(), tt.arg) {
+				t.Errorf("UnmarshalText() got %+v, want %+v", got, tt.arg)
+			}
+		})
+	}
+}
+
+func TestOptionalTextErrors(t *testing.T) {
+	o := NewExisting(1)
+	if err := o.UnmarshalText([]byte("abc")); err == nil {
+		t.Errorf("expected error when parsing text into an int")
+	}
+	if o.Get() != 1 {
+		t.Errorf("expected failed parse to keep the value, got %v", o.Get())
+	}
+
+	var small Optional[int8]
+	if err := small.UnmarshalText([]byte("300")); err == nil {
+		t.Errorf("expected error for an out of range int8")
+	}
+
+	if _, err := NewExisting([]int{1}).MarshalText(); err == nil {
+		t.Errorf("expected error for an unsupported type")
+	}
+}
+
+func TestOptionalJSONMapKey(t *testing.T) {
+	in := map[Optional[int]]string{NewExisting(1): "one", NewExisting(2): "two"}
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"1":"one","2":"two"}` {
+		t.Errorf("Marshal() got %s", data)
+	}
+
+	var out map[Optional[int]]string
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(in, out) {
+		t.Errorf("Unmarshal() got %v, want %v", out, in)
+	}
+}
+
+func TestOptionalFlag(t *testing.T) {
+	var port Optional[int]
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.TextVar(&port, "port", Empty[int](), "port")
+
+	if port.IsPresent() {
+		t.Errorf("expected port to be empty before parsing")
+	}
+	if err := fs.Parse([]string{"-port", "8080"}); err != nil {
+		t.Fatal(err)
+	}
+	if port != NewExisting(8080) {
+		t.Errorf("expected port 8080, got %+v", port)
+	}
+	if err := fs.Parse([]string{"-port", "http"}); err == nil {
+		t.Errorf("expected error for an invalid port")
+	}
+}
+
+type server struct {
+	XMLName xml.Name            `xml:"server"`
+	Name    Optional[string]    `xml:"name,attr"`
+	Port    Optional[int]       `xml:"port"`
+	Started Optional[time.Time] `xml:"started"`
+}
+
+func TestOptionalXML(t *testing.T) {
+	started := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    string
+		expected server
+	}{
+		{
+			"All present",
+			`<server name="web"><port>80</port><started>2024-01-02T03:04:05Z</started></server>`,
+			server{Name: NewExisting("web"), Port: NewExisting(80), Started: NewExisting(started)},
+		},
+		{
+			"Zero value present",
+			`<server name="web"><port>0</port></server>`,
+			server{Name: NewExisting("web"), Port: NewExisting(0)},
+		},
+		{
+			"All absent",
+			`<server></server>`,
+			server{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result server
+			if err := xml.Unmarshal([]byte(tt.input), &result); err != nil {
+				t.Fatalf("Failed to unmarshal: %v", err)
+			}
+			result.XMLName = xml.Name{}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, result)
+			}
+
+			data, err := xml.Marshal(result)
+			if err != nil {
+				t.Fatalf("Failed to marshal: %v", err)
+			}
+			if string(data) != tt.input {
+				t.Errorf("Expected %s, got %s", tt.input, data)
+			}
+		})
+	}
+}
+
+func TestOptionalTextEmptyString(t *testing.T) {
+	present := NewExisting("")
+
+	text, err := present.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(text) != 0 {
+		t.Errorf("MarshalText() got %q, want empty text", text)
+	}
+
+	got := NewExisting("previous")
+	if err := got.UnmarshalText(text); err != nil {
+		t.Fatal(err)
+	}
+	if got != Empty[string]() {
+		t.Errorf("expected a present empty string to come back as empty, got %+v", got)
+	}
+
+	var name Optional[string]
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.TextVar(&name, "name", Empty[string](), "name")
+	if err := fs.Parse([]string{"-name="}); err != nil {
+		t.Fatal(err)
+	}
+	if name.IsPresent() {
+		t.Errorf("expected an empty flag value to be empty, got %+v", name)
+	}
+
+	attr, err := present.MarshalXMLAttr(xml.Name{Local: "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromAttr Optional[string]
+	if err := fromAttr.UnmarshalXMLAttr(attr); err != nil {
+		t.Fatal(err)
+	}
+	if fromAttr.IsPresent() {
+		t.Errorf("expected an empty xml attribute to be empty, got %+v", fromAttr)
+	}
+}
+
+func TestOptionalXMLPointerReceiver(t *testing.T) {
+	type account struct {
+		XMLName xml.Name          `xml:"account"`
+		ID      Optional[big.Int] `xml:"id,attr"`
+		Balance Optional[big.Int] `xml:"balance"`
+	}
+
+	in := account{ID: NewExisting(*big.NewInt(7)), Balance: NewExisting(*big.NewInt(-42))}
+	data, err := xml.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `<account id="7"><balance>-42</balance></account>`; string(data) != want {
+		t.Errorf("Marshal() got %s, want %s", data, want)
+	}
+
+	var out account
+	if err := xml.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.ID.Value.Int64() != 7 || out.Balance.Value.Int64() != -42 || !out.ID.Exist || !out.Balance.Exist {
+		t.Errorf("Unmarshal() got %+v", out)
+	}
+}
@@ -0,0 +1,119 @@
+package gollections
+
+import (
+	"context"
+	"time"
+)
+
+// BlockingQueue is a bounded FIFO queue for producers and consumers, putting blocks while
+// the queue is full and taking blocks while it is empty. Its capacity is fixed on creation, so the
+// queue must be created with NewBlockingQueue, putting to or taking from a zero value panics.
+type BlockingQueue[T comparable] struct {
+	queue Queue[T]
+	slots chan struct{} // a token for each free slot
+	items chan struct{} // a token for each queued value
+}
+
+// NewBlockingQueue creates a new queue holding at most capacity values, it panics if capacity is less than one.
+func NewBlockingQueue[T comparable](capacity int) BlockingQueue[T] {
+	if capacity < 1 {
+		panic("blocking queue capacity must be at least one")
+	}
+
+	slots := make(chan struct{}, capacity)
+	for range capacity {
+		slots <- struct{}{}
+	}
+
+	return BlockingQueue[T]{
+		queue: NewQueue[T](),
+		slots: slots,
+		items: make(chan struct{}, capacity),
+	}
+}
+
+// mustInit panics if the queue was not created with NewBlockingQueue, as putting to or
+// taking from its nil channels would block forever.
+func (q *BlockingQueue[T]) mustInit() {
+	if q.slots == nil {
+		panic("blocking queue must be created with NewBlockingQueue")
+	}
+}
+
+// acquire takes a token from ch waiting until one is available or ctx is done.
+func acquire(ctx context.Context, ch chan struct{}) error {
+	// a free token is taken even if ctx is already done
+	select {
+	case <-ch:
+		return nil
+	default:
+	}
+
+	select {
+	case <-ch:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Put adds value to the end of the queue, waiting while the queue is full.
+// It returns the error of ctx if ctx is done before there is room.
+func (q *BlockingQueue[T]) Put(ctx context.Context, value T) error {
+	q.mustInit()
+	if err := acquire(ctx, q.slots); err != nil {
+		return err
+	}
+	q.queue.Enqueue(value)
+	q.items <- struct{}{}
+	return nil
+}
+
+// Take removes the value at the head of the queue, waiting while the queue is empty.
+// It returns the error of ctx if ctx is done before there is a value.
+func (q *BlockingQueue[T]) Take(ctx context.Context) (T, error) {
+	q.mustInit()
+	if err := acquire(ctx, q.items); err != nil {
+		var zero T
+		return zero, err
+	}
+	v, _ := q.queue.Dequeue()
+	q.slots <- struct{}{}
+	return v, nil
+}
+
+// Offer adds value to the end of the queue waiting at most timeout for room,
+// it returns false if the value was not added.
+func (q *BlockingQueue[T]) Offer(value T, timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return q.Put(ctx, value) == nil
+}
+
+// Poll removes the value at the head of the queue waiting at most timeout for one,
+// it returns false if there was no value.
+func (q *BlockingQueue[T]) Poll(timeout time.Duration) (T, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	v, err := q.Take(ctx)
+	return v, err == nil
+}
+
+// Peek returns the value at the head of the queue without removing it.
+func (q *BlockingQueue[T]) Peek() (T, bool) {
+	return q.queue.Peek()
+}
+
+// Size returns the number of queued values.
+func (q *BlockingQueue[T]) Size() int {
+	return len(q.items)
+}
+
+// Capacity returns the maximum number of values the queue can hold.
+func (q *BlockingQueue[T]) Capacity() int {
+	return cap(q.slots)
+}
+
+func (q *BlockingQueue[T]) IsEmpty() bool {
+	return q.Size() == 0
+}
@@ -0,0 +1,153 @@
+package gollections
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBlockingQueue_PutTake(t *testing.T) {
+	q := NewBlockingQueue[int](2)
+	ctx := context.Background()
+
+	if err := q.Put(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.Put(ctx, 2); err != nil {
+		t.Fatal(err)
+	}
+	if q.Size() != 2 || q.Capacity() != 2 {
+		t.Errorf("expected size 2 and capacity 2, got %d and %d", q.Size(), q.Capacity())
+	}
+
+	// the queue is full so putting blocks until the deadline
+	tctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := q.Put(tctx, 3); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded, got %v", err)
+	}
+
+	for _, want := range []int{1, 2} {
+		v, err := q.Take(ctx)
+		if err != nil || v != want {
+			t.Errorf("Take() got %d (%v), want %d", v, err, want)
+		}
+	}
+
+	// the queue is empty so taking blocks until cancelled
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := q.Take(cctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected canceled, got %v", err)
+	}
+	if !q.IsEmpty() {
+		t.Errorf("expected queue to be empty")
+	}
+}
+
+func TestBlockingQueue_OfferPoll(t *testing.T) {
+	q := NewBlockingQueue[string](1)
+
+	if !q.Offer("a", 0) {
+		t.Errorf("expected offer to succeed")
+	}
+	if q.Offer("b", 5*time.Millisecond) {
+		t.Errorf("expected offer to full queue to time out")
+	}
+	if v, ok := q.Peek(); v != "a" || !ok {
+		t.Errorf("Peek() got %v (%v), want a (true)", v, ok)
+	}
+	if v, ok := q.Poll(0); v != "a" || !ok {
+		t.Errorf("Poll() got %v (%v), want a (true)", v, ok)
+	}
+	if _, ok := q.Poll(5 * time.Millisecond); ok {
+		t.Errorf("expected poll from empty queue to time out")
+	}
+
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		q.Offer("c", time.Second)
+	}()
+	if v, ok := q.Poll(time.Second); v != "c" || !ok {
+		t.Errorf("Poll() got %v (%v), want c (true)", v, ok)
+	}
+}
+
+func TestBlockingQueue_ProducerConsumer(t *testing.T) {
+	const producers, perProducer = 4, 100
+
+	q := NewBlockingQueue[int](3)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for p := range producers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perProducer {
+				if err := q.Put(ctx, p*perProducer+i); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+
+	seen := make(map[int]bool)
+	for range producers * perProducer {
+		v, err := q.Take(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if seen[v] {
+			t.Errorf("value %d taken twice", v)
+		}
+		seen[v] = true
+		if q.Size() > q.Capacity() {
+			t.Errorf("size %d exceeds capacity %d", q.Size(), q.Capacity())
+		}
+	}
+	wg.Wait()
+
+	if len(seen) != producers*perProducer || !q.IsEmpty() {
+		t.Errorf("expected all %d values to be taken, got %d", producers*perProducer, len(seen))
+	}
+}
+
+func TestNewBlockingQueue_InvalidCapacity(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected panic for zero capacity")
+		}
+	}()
+	NewBlockingQueue[int](0)
+}
+
+func TestBlockingQueue_ZeroValue(t *testing.T) {
+	var q BlockingQueue[int]
+
+	if q.Size() != 0 || q.Capacity() != 0 || !q.IsEmpty() {
+		t.Errorf("expected zero value to be empty with no capacity")
+	}
+
+	tests := []struct {
+		name string
+		f    func()
+	}{
+		{name: "Put", f: func() { _ = q.Put(context.Background(), 1) }},
+		{name: "Take", f: func() { _, _ = q.Take(context.Background()) }},
+		{name: "Offer", f: func() { q.Offer(1, time.Millisecond) }},
+		{name: "Poll", f: func() { q.Poll(time.Millisecond) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected %s on a zero value to panic", tt.name)
+				}
+			}()
+			tt.f()
+		})
+	}
+}
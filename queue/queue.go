@@ -1,22 +1,36 @@
 package gollections
 
 import (
+	"encoding/json"
+	"iter"
 	"sync"
 
-	"github.com/johannessarpola/gollections/internal/node"
+	"github.com/johannessarpola/gollections/internal/ring"
+	"github.com/johannessarpola/gollections/internal/seqs"
 )
 
-// Queue FIFO data structure
+// Queue FIFO data structure, backed by a ring buffer
 type Queue[T comparable] struct {
-	head *node.Node[T]
-	last *node.Node[T]
-	mu   sync.Mutex
+	values ring.Buffer[T]
+	mu     sync.Mutex
 }
 
 func NewQueue[T comparable]() Queue[T] {
 	return Queue[T]{}
 }
 
+// FromSeq creates a new queue enqueuing the values of seq in order.
+func FromSeq[T comparable](seq iter.Seq[T]) Queue[T] {
+	var values ring.Buffer[T]
+	for v := range seq {
+		values.PushBack(v)
+	}
+
+	return Queue[T]{
+		values: values,
+	}
+}
+
 func (r *Queue[T]) withLock(f func()) {
 	defer r.mu.Unlock()
 	r.mu.Lock()
@@ -25,15 +39,7 @@ func (r *Queue[T]) withLock(f func()) {
 
 func (q *Queue[T]) Enqueue(value T) {
 	q.withLock(func() {
-		n := node.NewNode(value)
-		if q.last != nil {
-			q.last.Next = &n
-		}
-		q.last = &n
-
-		if q.head == nil {
-			q.head = &n
-		}
+		q.values.PushBack(value)
 	})
 }
 
@@ -44,15 +50,7 @@ func (q *Queue[T]) Dequeue() (T, bool) {
 	)
 
 	q.withLock(func() {
-		if q.head != nil {
-			val, _ = q.head.Get()
-			ok = true
-			q.head = q.head.Next
-
-			if q.head == nil {
-				q.last = nil
-			}
-		}
+		val, ok = q.values.PopFront()
 	})
 
 	return val, ok
@@ -60,37 +58,76 @@ func (q *Queue[T]) Dequeue() (T, bool) {
 
 func (q *Queue[T]) Peek() (T, bool) {
 	var (
-		val     T
-		nonZero bool
+		val T
+		ok  bool
 	)
 
 	q.withLock(func() {
-		if q.head != nil {
-			val, nonZero = q.head.Get()
-		}
+		val, ok = q.values.Front()
 	})
-	return val, nonZero
+	return val, ok
 }
 
 func (q *Queue[T]) Size() int {
 	i := 0
 	q.withLock(func() {
-		current := q.head
-		if current != nil {
-			i++
-			for current.Next != nil {
-				current = current.Next
-				i++
-			}
-		}
+		i = q.values.Len()
 	})
 	return i
 }
 
 func (q *Queue[T]) IsEmpty() bool {
-	b := false
+	return q.Size() == 0
+}
+
+func (q *Queue[T]) Clear() {
+	q.withLock(func() {
+		q.values.Clear()
+	})
+}
+
+// snapshot copies the values from the head to the end while holding the lock.
+func (q *Queue[T]) snapshot() []T {
+	var values []T
 	q.withLock(func() {
-		b = q.head == nil
+		values = q.values.Slice()
 	})
-	return b
+	return values
+}
+
+// All iterates the values with their indexes from the head to the end of the queue without dequeuing them.
+func (q *Queue[T]) All() iter.Seq2[int, T] {
+	return seqs.Snapshot(q.snapshot)
+}
+
+// Values iterates the values from the head to the end of the queue without dequeuing them.
+func (q *Queue[T]) Values() iter.Seq[T] {
+	return seqs.Values(q.All())
+}
+
+// Items returns the values from the head to the end of the queue without dequeuing them.
+func (q *Queue[T]) Items() []T {
+	return q.snapshot()
+}
+
+func (q *Queue[T]) UnmarshalJSON(data []byte) error {
+	var aux []T
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	q.withLock(func() {
+		for _, v := range aux {
+			q.values.PushBack(v)
+		}
+	})
+
+	return nil
+}
+
+// MarshalJSON marshals the values as an array in FIFO order.
+func (q *Queue[T]) MarshalJSON() ([]byte, error) {
+	items := q.Items()
+	return json.Marshal(items)
 }
@@ -1,6 +1,9 @@
 package gollections
 
 import (
+	"encoding/json"
+	"reflect"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -14,20 +17,16 @@ func TestQueue_Enqueue(t *testing.T) {
 	q.Enqueue(20)
 	q.Enqueue(30)
 
-	if q.head == nil || q.head.Inner != 10 {
-		t.Errorf("Expected head value to be 10, but got %v", q.head)
+	if v, ok := q.Peek(); !ok || v != 10 {
+		t.Errorf("Expected head value to be 10, but got %v", v)
 	}
 
-	if q.head.Next == nil || q.head.Next.Inner != 20 {
-		t.Errorf("Expected head value to be 20, but got %v", q.head.Next)
+	if got := slices.Collect(q.Values()); !reflect.DeepEqual(got, []int{10, 20, 30}) {
+		t.Errorf("Expected values to be [10 20 30], but got %v", got)
 	}
 
-	if q.last == nil || q.last.Inner != 30 {
-		t.Errorf("Expected last value to be 30, but got %v", q.last)
-	}
-
-	if q.last.Next != nil {
-		t.Errorf("Expected last values next to be nil, but got %v", q.last.Next)
+	if s := q.Size(); s != 3 {
+		t.Errorf("Expected to have 3 elements, but got %v", s)
 	}
 }
 
@@ -35,27 +34,27 @@ func TestQueue_Dequeue(t *testing.T) {
 	q := Queue[int]{}
 
 	q.Enqueue(10)
-	if q.last == nil || q.last.Inner != 10 || q.head.Inner != 10 {
-		t.Errorf("Expected last value to be 10, but got %v", q.last)
+	if v, _ := q.Peek(); v != 10 || q.Size() != 1 {
+		t.Errorf("Expected head value to be 10, but got %v", v)
 	}
 
 	q.Enqueue(20)
-	if q.last == nil || q.last.Inner != 20 || q.head.Inner != 10 {
-		t.Errorf("Expected last value to be 20, but got %v", q.last)
+	if v, _ := q.Peek(); v != 10 || q.Size() != 2 {
+		t.Errorf("Expected head value to be 10, but got %v", v)
 	}
 
 	q.Enqueue(30)
-	if q.last == nil || q.last.Inner != 30 || q.head.Inner != 10 {
-		t.Errorf("Expected last value to be 30, but got %v", q.last)
+	if v, _ := q.Peek(); v != 10 || q.Size() != 3 {
+		t.Errorf("Expected head value to be 10, but got %v", v)
 	}
 
 	v, ok := q.Dequeue()
-	if !ok || v != 10 || q.head.Inner == 10 {
+	if h, _ := q.Peek(); !ok || v != 10 || h != 20 {
 		t.Errorf("Expected to dequeue 10, but got %v", v)
 	}
 
 	v, ok = q.Dequeue()
-	if !ok || v != 20 || q.head.Inner == 20 {
+	if h, _ := q.Peek(); !ok || v != 20 || h != 30 {
 		t.Errorf("Expected to dequeue 20, but got %v", v)
 	}
 
@@ -73,12 +72,21 @@ func TestQueue_Dequeue(t *testing.T) {
 		t.Errorf("Expected to have 0 elements, but got %v", s)
 	}
 
-	if q.last != nil {
-		t.Errorf("Expected last to be nil, but got %v", q.last)
+	if _, ok := q.Peek(); ok {
+		t.Errorf("Expected nothing to peek from an empty queue")
 	}
+}
 
-	if q.head != nil {
-		t.Errorf("Expected head to be nil, but got %v", q.head)
+func TestQueue_ZeroValues(t *testing.T) {
+	q := NewQueue[int]()
+	q.Enqueue(0)
+
+	// zero values are values like any other
+	if v, ok := q.Peek(); v != 0 || !ok {
+		t.Errorf("Expected to peek 0, but got %v (%v)", v, ok)
+	}
+	if v, ok := q.Dequeue(); v != 0 || !ok {
+		t.Errorf("Expected to dequeue 0, but got %v (%v)", v, ok)
 	}
 }
 
@@ -135,3 +143,105 @@ func TestQueue_ThreadSafety(t *testing.T) {
 		t.Errorf("Expected %v, but got %v", pcntr.Load(), dcntr.Load())
 	}
 }
+
+func TestQueue_Iterators(t *testing.T) {
+	q := FromSeq(slices.Values([]int{10, 20, 30}))
+
+	if got := slices.Collect(q.Values()); !reflect.DeepEqual(got, []int{10, 20, 30}) {
+		t.Errorf("Values() got %v, want %v", got, []int{10, 20, 30})
+	}
+
+	for i, v := range q.All() {
+		if v != (i+1)*10 {
+			t.Errorf("All() got %d at index %d, want %d", v, i, (i+1)*10)
+		}
+	}
+
+	// iteration does not dequeue
+	if v, ok := q.Dequeue(); v != 10 || !ok {
+		t.Errorf("expected to dequeue 10, got %v", v)
+	}
+
+	q.Enqueue(40)
+	if got := slices.Collect(q.Values()); !reflect.DeepEqual(got, []int{20, 30, 40}) {
+		t.Errorf("Values() got %v, want %v", got, []int{20, 30, 40})
+	}
+}
+
+func TestQueue_ModifyWhileIterating(t *testing.T) {
+	q := FromSeq(slices.Values([]int{1, 2, 3}))
+
+	for v := range q.Values() {
+		if d, _ := q.Dequeue(); d != v {
+			t.Errorf("expected to dequeue %d, got %d", v, d)
+		}
+		q.Enqueue(v * 10)
+	}
+
+	if got := slices.Collect(q.Values()); !reflect.DeepEqual(got, []int{10, 20, 30}) {
+		t.Errorf("got %v, want %v", got, []int{10, 20, 30})
+	}
+}
+
+func TestQueue_JSON(t *testing.T) {
+	q := NewQueue[int]()
+	q.Enqueue(1)
+	q.Enqueue(2)
+	q.Enqueue(3)
+
+	d, err := json.Marshal(&q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(d) != "[1,2,3]" {
+		t.Errorf("MarshalJSON() got = %v, want %v", string(d), "[1,2,3]")
+	}
+
+	// marshalling does not dequeue
+	if q.Size() != 3 {
+		t.Errorf("expected size 3 after marshalling, got %d", q.Size())
+	}
+
+	var nq Queue[int]
+	if err := json.Unmarshal(d, &nq); err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := nq.Dequeue(); v != 1 || !ok {
+		t.Errorf("expected to dequeue 1 first, got %v", v)
+	}
+
+	type contained struct {
+		Field string        `json:"field"`
+		Queue Queue[string] `json:"queue"`
+	}
+
+	var c contained
+	if err := json.Unmarshal([]byte(`{"field": "value", "queue": ["a", "b", "c"]}`), &c); err != nil {
+		t.Fatal(err)
+	}
+	if got := c.Queue.Items(); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("UnmarshalJSON() got %v, want %v", got, []string{"a", "b", "c"})
+	}
+
+	d, err = json.Marshal(&c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"field":"value","queue":["a","b","c"]}`; string(d) != want {
+		t.Errorf("MarshalJSON() got = %v, want %v", string(d), want)
+	}
+}
+
+func TestQueue_Clear(t *testing.T) {
+	q := FromSeq(slices.Values([]int{1, 2, 3}))
+	q.Clear()
+
+	if !q.IsEmpty() || len(q.Items()) != 0 {
+		t.Errorf("expected queue to be empty after Clear, got %v", q.Items())
+	}
+
+	q.Enqueue(4)
+	if got := q.Items(); !reflect.DeepEqual(got, []int{4}) {
+		t.Errorf("got %v, want %v", got, []int{4})
+	}
+}
@@ -1,5 +1,9 @@
 package result
 
+import (
+	"fmt"
+)
+
 // Result is a wrapper for the value and an error.
 type Result[T any] struct {
 	val T
@@ -74,3 +78,57 @@ func (r Result[T]) OrElseFunc(fallback func() T) T {
 func (r Result[T]) Get() (T, error) {
 	return r.Value(), r.Err()
 }
+
+// Recover calls `f` with the error if the Result is not ok, so the error can be turned into a value.
+func (r Result[T]) Recover(f func(error) Result[T]) Result[T] {
+	if r.OK() {
+		return r
+	}
+	return f(r.err)
+}
+
+// Tap calls `f` with the value if the Result is ok and returns the Result unchanged.
+func (r Result[T]) Tap(f func(T)) Result[T] {
+	if r.OK() {
+		f(r.val)
+	}
+	return r
+}
+
+// TapErr calls `f` with the error if the Result is not ok and returns the Result unchanged.
+func (r Result[T]) TapErr(f func(error)) Result[T] {
+	if !r.OK() {
+		f(r.err)
+	}
+	return r
+}
+
+// Must returns the value and panics with the error if the Result is not ok.
+func (r Result[T]) Must() T {
+	if !r.OK() {
+		panic(r.err)
+	}
+	return r.val
+}
+
+// Expect returns the value and panics with the error prefixed by msg if the Result is not ok.
+func (r Result[T]) Expect(msg string) T {
+	if !r.OK() {
+		panic(fmt.Errorf("%s: %w", msg, r.err))
+	}
+	return r.val
+}
+
+// Context prefixes the error with the formatted message, the original error is wrapped
+// so it can still be matched with errors.Is and errors.As.
+func (r Result[T]) Context(format string, args ...any) Result[T] {
+	if r.OK() {
+		return r
+	}
+	return Result[T]{val: r.val, err: fmt.Errorf("%s: %w", fmt.Sprintf(format, args...), r.err)}
+}
+
+// Unwrap returns the error so a Result can be used with errors.Is and errors.As.
+func (r Result[T]) Unwrap() error {
+	return r.err
+}
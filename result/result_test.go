@@ -40,3 +40,132 @@ func TestOrElseFunc(t *testing.T) {
 		t.Errorf("expected 42, got %v", val)
 	}
 }
+
+func TestRecover(t *testing.T) {
+	errInitial := errors.New("initial error")
+
+	tests := []struct {
+		name    string
+		r       Result[int]
+		want    int
+		wantErr bool
+	}{
+		{name: "ok", r: NewOk(10), want: 10},
+		{name: "recovered", r: NewErr[int](errInitial), want: 42},
+		{name: "not recovered", r: NewErr[int](errors.New("other error")), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.r.Recover(func(err error) Result[int] {
+				if errors.Is(err, errInitial) {
+					return NewOk(42)
+				}
+				return NewErr[int](err)
+			})
+			if got.IsErr() != tt.wantErr {
+				t.Errorf("expected error %v, got %v", tt.wantErr, got.Err())
+			}
+			if !tt.wantErr && got.Value() != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got.Value())
+			}
+		})
+	}
+}
+
+func TestTap(t *testing.T) {
+	var (
+		values []int
+		errs   []error
+	)
+	errInitial := errors.New("initial error")
+
+	for _, r := range []Result[int]{NewOk(1), NewErr[int](errInitial), NewOk(2)} {
+		got := r.
+			Tap(func(v int) { values = append(values, v) }).
+			TapErr(func(err error) { errs = append(errs, err) })
+		if got != r {
+			t.Errorf("expected result to be unchanged, got %v", got)
+		}
+	}
+
+	if len(values) != 2 || values[0] != 1 || values[1] != 2 {
+		t.Errorf("expected [1 2], got %v", values)
+	}
+	if len(errs) != 1 || errs[0] != errInitial {
+		t.Errorf("expected [initial error], got %v", errs)
+	}
+}
+
+func TestMust(t *testing.T) {
+	if got := NewOk(10).Must(); got != 10 {
+		t.Errorf("expected 10, got %v", got)
+	}
+
+	errInitial := errors.New("initial error")
+	defer func() {
+		rec := recover()
+		if err, ok := rec.(error); !ok || !errors.Is(err, errInitial) {
+			t.Errorf("expected panic with initial error, got %v", rec)
+		}
+	}()
+	NewErr[int](errInitial).Must()
+}
+
+func TestExpect(t *testing.T) {
+	if got := NewOk(10).Expect("loading config"); got != 10 {
+		t.Errorf("expected 10, got %v", got)
+	}
+
+	errInitial := errors.New("initial error")
+	defer func() {
+		rec := recover()
+		err, ok := rec.(error)
+		if !ok || !errors.Is(err, errInitial) {
+			t.Fatalf("expected panic with initial error, got %v", rec)
+		}
+		if err.Error() != "loading config: initial error" {
+			t.Errorf("unexpected message %q", err.Error())
+		}
+	}()
+	NewErr[int](errInitial).Expect("loading config")
+}
+
+type notFoundError struct{ key string }
+
+func (e *notFoundError) Error() string { return e.key + " not found" }
+
+func TestContext(t *testing.T) {
+	ok := NewOk(10).Context("reading %s", "config")
+	if ok.IsErr() || ok.Value() != 10 {
+		t.Errorf("expected ok result to be unchanged, got %v", ok)
+	}
+
+	r := NewErr[int](&notFoundError{key: "port"}).Context("reading %s", "config")
+	if r.Error() != "reading config: port not found" {
+		t.Errorf("unexpected message %q", r.Error())
+	}
+
+	var nf *notFoundError
+	if !errors.As(r.Err(), &nf) || nf.key != "port" {
+		t.Errorf("expected wrapped error to be found with errors.As, got %v", r.Err())
+	}
+}
+
+func TestResultUnwrap(t *testing.T) {
+	errInitial := errors.New("initial error")
+	r := NewErr[int](errInitial).Context("wrapped")
+
+	if !errors.Is(r, errInitial) {
+		t.Errorf("expected errors.Is to match through the result")
+	}
+
+	var nf *notFoundError
+	if !errors.As(NewErr[int](&notFoundError{key: "port"}), &nf) {
+		t.Errorf("expected errors.As to match through the result")
+	}
+
+	if NewOk(10).Unwrap() != nil {
+		t.Errorf("expected nil error for ok result")
+	}
+}
@@ -0,0 +1,139 @@
+package gollections
+
+// empty returns a new set with the configuration of s.
+func (s *Set[T]) empty() *Set[T] {
+	rs := New[T]()
+	rs.compare = s.compare
+	return rs
+}
+
+// Union returns a new set with the values which are in either set.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	rs := s.empty()
+	for v := range s.internal {
+		rs.internal[v] = struct{}{}
+	}
+	for v := range other.internal {
+		rs.internal[v] = struct{}{}
+	}
+	return rs
+}
+
+// Intersection returns a new set with the values which are in both sets.
+func (s *Set[T]) Intersection(other *Set[T]) *Set[T] {
+	rs := s.empty()
+	small, large := s.internal, other.internal
+	if len(large) < len(small) {
+		small, large = large, small
+	}
+	for v := range small {
+		if _, ok := large[v]; ok {
+			rs.internal[v] = struct{}{}
+		}
+	}
+	return rs
+}
+
+// Difference returns a new set with the values of s which are not in other.
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	rs := s.empty()
+	for v := range s.internal {
+		if _, ok := other.internal[v]; !ok {
+			rs.internal[v] = struct{}{}
+		}
+	}
+	return rs
+}
+
+// SymmetricDifference returns a new set with the values which are in exactly one of the sets.
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	rs := s.Difference(other)
+	for v := range other.internal {
+		if _, ok := s.internal[v]; !ok {
+			rs.internal[v] = struct{}{}
+		}
+	}
+	return rs
+}
+
+// UnionWith adds the values of other to s.
+func (s *Set[T]) UnionWith(other *Set[T]) *Set[T] {
+	s.lazyInit()
+	for v := range other.internal {
+		s.internal[v] = struct{}{}
+	}
+	return s
+}
+
+// IntersectWith removes the values from s which are not in other.
+func (s *Set[T]) IntersectWith(other *Set[T]) *Set[T] {
+	for v := range s.internal {
+		if _, ok := other.internal[v]; !ok {
+			delete(s.internal, v)
+		}
+	}
+	return s
+}
+
+// DifferenceWith removes the values of other from s.
+func (s *Set[T]) DifferenceWith(other *Set[T]) *Set[T] {
+	for v := range other.internal {
+		delete(s.internal, v)
+	}
+	return s
+}
+
+// SymmetricDifferenceWith removes the values from s which are in other and adds the ones which are not.
+func (s *Set[T]) SymmetricDifferenceWith(other *Set[T]) *Set[T] {
+	if s == other {
+		clear(s.internal)
+		return s
+	}
+
+	s.lazyInit()
+	for v := range other.internal {
+		if _, ok := s.internal[v]; ok {
+			delete(s.internal, v)
+		} else {
+			s.internal[v] = struct{}{}
+		}
+	}
+	return s
+}
+
+// IsSubsetOf tells if all the values of s are in other.
+func (s *Set[T]) IsSubsetOf(other *Set[T]) bool {
+	if len(s.internal) > len(other.internal) {
+		return false
+	}
+	for v := range s.internal {
+		if _, ok := other.internal[v]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSupersetOf tells if all the values of other are in s.
+func (s *Set[T]) IsSupersetOf(other *Set[T]) bool {
+	return other.IsSubsetOf(s)
+}
+
+// IsDisjoint tells if the sets have no values in common.
+func (s *Set[T]) IsDisjoint(other *Set[T]) bool {
+	small, large := s.internal, other.internal
+	if len(large) < len(small) {
+		small, large = large, small
+	}
+	for v := range small {
+		if _, ok := large[v]; ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal tells if the sets have the same values.
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	return len(s.internal) == len(other.internal) && s.IsSubsetOf(other)
+}
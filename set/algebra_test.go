@@ -0,0 +1,156 @@
+package gollections
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/johannessarpola/gollections/comps"
+)
+
+func sorted(s *Set[int]) []int {
+	values := s.Items()
+	slices.Sort(values)
+	return values
+}
+
+func TestSet_Algebra(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     []int
+		union    []int
+		inter    []int
+		diff     []int
+		symDiff  []int
+		subset   bool
+		superset bool
+		disjoint bool
+		equal    bool
+	}{
+		{
+			name:  "overlapping",
+			a:     []int{1, 2, 3},
+			b:     []int{2, 3, 4},
+			union: []int{1, 2, 3, 4}, inter: []int{2, 3}, diff: []int{1}, symDiff: []int{1, 4},
+		},
+		{
+			name:  "subset",
+			a:     []int{1, 2},
+			b:     []int{1, 2, 3},
+			union: []int{1, 2, 3}, inter: []int{1, 2}, diff: []int{}, symDiff: []int{3},
+			subset: true,
+		},
+		{
+			name:  "superset",
+			a:     []int{1, 2, 3},
+			b:     []int{3},
+			union: []int{1, 2, 3}, inter: []int{3}, diff: []int{1, 2}, symDiff: []int{1, 2},
+			superset: true,
+		},
+		{
+			name:  "disjoint",
+			a:     []int{1, 2},
+			b:     []int{3, 4},
+			union: []int{1, 2, 3, 4}, inter: []int{}, diff: []int{1, 2}, symDiff: []int{1, 2, 3, 4},
+			disjoint: true,
+		},
+		{
+			name:  "equal",
+			a:     []int{1, 2},
+			b:     []int{2, 1},
+			union: []int{1, 2}, inter: []int{1, 2}, diff: []int{}, symDiff: []int{},
+			subset: true, superset: true, equal: true,
+		},
+		{
+			name:  "empty",
+			a:     []int{},
+			b:     []int{},
+			union: []int{}, inter: []int{}, diff: []int{}, symDiff: []int{},
+			subset: true, superset: true, disjoint: true, equal: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := FromSeq(slices.Values(tt.a)), FromSeq(slices.Values(tt.b))
+
+			if got := sorted(a.Union(b)); !reflect.DeepEqual(got, tt.union) {
+				t.Errorf("Union() got %v, want %v", got, tt.union)
+			}
+			if got := sorted(a.Intersection(b)); !reflect.DeepEqual(got, tt.inter) {
+				t.Errorf("Intersection() got %v, want %v", got, tt.inter)
+			}
+			if got := sorted(a.Difference(b)); !reflect.DeepEqual(got, tt.diff) {
+				t.Errorf("Difference() got %v, want %v", got, tt.diff)
+			}
+			if got := sorted(a.SymmetricDifference(b)); !reflect.DeepEqual(got, tt.symDiff) {
+				t.Errorf("SymmetricDifference() got %v, want %v", got, tt.symDiff)
+			}
+			if got := a.IsSubsetOf(b); got != tt.subset {
+				t.Errorf("IsSubsetOf() got %v, want %v", got, tt.subset)
+			}
+			if got := a.IsSupersetOf(b); got != tt.superset {
+				t.Errorf("IsSupersetOf() got %v, want %v", got, tt.superset)
+			}
+			if got := a.IsDisjoint(b); got != tt.disjoint {
+				t.Errorf("IsDisjoint() got %v, want %v", got, tt.disjoint)
+			}
+			if got := a.Equal(b); got != tt.equal {
+				t.Errorf("Equal() got %v, want %v", got, tt.equal)
+			}
+
+			// the operands are left untouched by the operations returning new sets
+			if got := sorted(a); !comps.UnorderedEquals(got, tt.a) {
+				t.Errorf("operand changed to %v", got)
+			}
+
+			inPlace := []struct {
+				name string
+				op   func(s *Set[int]) *Set[int]
+				want []int
+			}{
+				{name: "UnionWith", op: func(s *Set[int]) *Set[int] { return s.UnionWith(b) }, want: tt.union},
+				{name: "IntersectWith", op: func(s *Set[int]) *Set[int] { return s.IntersectWith(b) }, want: tt.inter},
+				{name: "DifferenceWith", op: func(s *Set[int]) *Set[int] { return s.DifferenceWith(b) }, want: tt.diff},
+				{name: "SymmetricDifferenceWith", op: func(s *Set[int]) *Set[int] { return s.SymmetricDifferenceWith(b) }, want: tt.symDiff},
+			}
+			for _, ip := range inPlace {
+				s := FromSeq(slices.Values(tt.a))
+				if rs := ip.op(s); rs != s {
+					t.Errorf("%s() expected to return the receiver", ip.name)
+				}
+				if got := sorted(s); !reflect.DeepEqual(got, ip.want) {
+					t.Errorf("%s() got %v, want %v", ip.name, got, ip.want)
+				}
+			}
+		})
+	}
+}
+
+func TestSet_AlgebraSameSet(t *testing.T) {
+	s := FromSeq(slices.Values([]int{1, 2, 3}))
+
+	if got := sorted(s.Union(s)); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Union() got %v", got)
+	}
+	if got := sorted(s.Intersection(s)); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Intersection() got %v", got)
+	}
+	if s.Difference(s).Size() != 0 || s.SymmetricDifference(s).Size() != 0 {
+		t.Errorf("expected difference of a set with itself to be empty")
+	}
+	if !s.IsSubsetOf(s) || !s.IsSupersetOf(s) || !s.Equal(s) || s.IsDisjoint(s) {
+		t.Errorf("unexpected comparison of a set with itself")
+	}
+
+	s.UnionWith(s).IntersectWith(s)
+	if got := sorted(s); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("got %v, want %v", got, []int{1, 2, 3})
+	}
+	if s.SymmetricDifferenceWith(s).Size() != 0 {
+		t.Errorf("expected SymmetricDifferenceWith() of a set with itself to be empty")
+	}
+	s.AddAll(1, 2)
+	if s.DifferenceWith(s).Size() != 0 {
+		t.Errorf("expected DifferenceWith() of a set with itself to be empty")
+	}
+}
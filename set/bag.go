@@ -0,0 +1,267 @@
+package gollections
+
+import (
+	"bytes"
+	"cmp"
+	"encoding"
+	"encoding/json"
+	"iter"
+	"maps"
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/johannessarpola/gollections/internal/seqs"
+)
+
+// BagEntry is a value of a Bag with the number of times it occurs.
+type BagEntry[T comparable] struct {
+	Value T   `json:"value"`
+	Count int `json:"count"`
+}
+
+// Bag is a multiset which counts the occurrences of its values, it is safe for concurrent use.
+// Unlike Set it has no unsynchronized variant: Add and Remove read and update a count, so a
+// shared bag would need a lock around every call, and an uncontended RWMutex costs little
+// next to the map access. Readers take a shared lock as in ConcurrentSet.
+type Bag[T comparable] struct {
+	internal map[T]int
+	size     int
+	mu       sync.RWMutex
+}
+
+// NewBag creates a new Bag.
+func NewBag[T comparable]() *Bag[T] {
+	return &Bag[T]{internal: make(map[T]int)}
+}
+
+// BagFromSeq creates a new Bag counting the values of seq.
+func BagFromSeq[T comparable](seq iter.Seq[T]) *Bag[T] {
+	b := NewBag[T]()
+	for v := range seq {
+		b.internal[v]++
+		b.size++
+	}
+	return b
+}
+
+func (b *Bag[T]) withLock(f func()) {
+	defer b.mu.Unlock()
+	b.mu.Lock()
+	f()
+}
+
+func (b *Bag[T]) withRLock(f func()) {
+	defer b.mu.RUnlock()
+	b.mu.RLock()
+	f()
+}
+
+// add adds n occurrences of value, the lock must be held.
+func (b *Bag[T]) add(value T, n int) {
+	if n <= 0 {
+		return
+	}
+	if b.internal == nil {
+		b.internal = make(map[T]int)
+	}
+	b.internal[value] += n
+	b.size += n
+}
+
+// Add adds n occurrences of value, nothing is added if n is not positive.
+func (b *Bag[T]) Add(value T, n int) {
+	b.withLock(func() {
+		b.add(value, n)
+	})
+}
+
+// Remove removes at most n occurrences of value and returns how many were removed.
+func (b *Bag[T]) Remove(value T, n int) int {
+	removed := 0
+	b.withLock(func() {
+		c := b.internal[value]
+		removed = max(0, min(n, c))
+		if removed == c {
+			delete(b.internal, value)
+		} else {
+			b.internal[value] = c - removed
+		}
+		b.size -= removed
+	})
+	return removed
+}
+
+// Count returns the number of occurrences of value.
+func (b *Bag[T]) Count(value T) int {
+	c := 0
+	b.withRLock(func() {
+		c = b.internal[value]
+	})
+	return c
+}
+
+func (b *Bag[T]) Contains(value T) bool {
+	return b.Count(value) > 0
+}
+
+// Size returns the total number of occurrences of all the values.
+func (b *Bag[T]) Size() int {
+	i := 0
+	b.withRLock(func() {
+		i = b.size
+	})
+	return i
+}
+
+// Distinct returns a set of the values without their counts.
+func (b *Bag[T]) Distinct() *Set[T] {
+	s := New[T]()
+	b.withRLock(func() {
+		for v := range b.internal {
+			s.internal[v] = struct{}{}
+		}
+	})
+	return s
+}
+
+// MostCommon returns the k values with the highest counts in descending order of count,
+// all the values when k is not positive. Values with equal counts are in unspecified order.
+func (b *Bag[T]) MostCommon(k int) []BagEntry[T] {
+	entries := b.Entries()
+	slices.SortStableFunc(entries, func(a, b BagEntry[T]) int {
+		return cmp.Compare(b.Count, a.Count)
+	})
+	if k > 0 && k < len(entries) {
+		entries = entries[:k]
+	}
+	return entries
+}
+
+func (b *Bag[T]) Clear() {
+	b.withLock(func() {
+		b.internal = make(map[T]int)
+		b.size = 0
+	})
+}
+
+// snapshot copies the counts while holding the lock.
+func (b *Bag[T]) snapshot() map[T]int {
+	var counts map[T]int
+	b.withRLock(func() {
+		counts = maps.Clone(b.internal)
+	})
+	return counts
+}
+
+// Union returns a new bag with the larger count of each value of the bags.
+func (b *Bag[T]) Union(other *Bag[T]) *Bag[T] {
+	rs := NewBag[T]()
+	rs.internal = b.snapshot()
+	if rs.internal == nil {
+		rs.internal = make(map[T]int)
+	}
+	for v, c := range other.snapshot() {
+		if c > rs.internal[v] {
+			rs.internal[v] = c
+		}
+	}
+	for _, c := range rs.internal {
+		rs.size += c
+	}
+	return rs
+}
+
+// Intersection returns a new bag with the smaller count of each value which is in both bags.
+func (b *Bag[T]) Intersection(other *Bag[T]) *Bag[T] {
+	rs := NewBag[T]()
+	counts := other.snapshot()
+	for v, c := range b.snapshot() {
+		rs.add(v, min(c, counts[v]))
+	}
+	return rs
+}
+
+// Entries returns the values with their counts, the order is not specified.
+func (b *Bag[T]) Entries() []BagEntry[T] {
+	var entries []BagEntry[T]
+	b.withRLock(func() {
+		entries = make([]BagEntry[T], 0, len(b.internal))
+		for v, c := range b.internal {
+			entries = append(entries, BagEntry[T]{Value: v, Count: c})
+		}
+	})
+	return entries
+}
+
+// All iterates the values with their counts, the order is not specified.
+func (b *Bag[T]) All() iter.Seq2[T, int] {
+	return seqs.SnapshotMap(b.snapshot)
+}
+
+// Values iterates each occurrence of the values, so a value is repeated as many times as it is counted.
+func (b *Bag[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v, c := range b.All() {
+			for range c {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Items returns each occurrence of the values, the order is not specified.
+func (b *Bag[T]) Items() []T {
+	return slices.Collect(b.Values())
+}
+
+// textKeys tells if T can be a json object key, which is the case for strings, integers
+// and types implementing encoding.TextMarshaler.
+func textKeys[T comparable]() bool {
+	t := reflect.TypeFor[T]()
+	switch t.Kind() {
+	case reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return t.Implements(reflect.TypeFor[encoding.TextMarshaler]()) &&
+		reflect.PointerTo(t).Implements(reflect.TypeFor[encoding.TextUnmarshaler]())
+}
+
+// UnmarshalJSON accepts both the object and the array of entries produced by MarshalJSON,
+// the counts are added to the bag.
+func (b *Bag[T]) UnmarshalJSON(data []byte) error {
+	var entries []BagEntry[T]
+
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		var counts map[T]int
+		if err := json.Unmarshal(data, &counts); err != nil {
+			return err
+		}
+		for v, c := range counts {
+			entries = append(entries, BagEntry[T]{Value: v, Count: c})
+		}
+	} else if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+
+	b.withLock(func() {
+		for _, e := range entries {
+			b.add(e.Value, e.Count)
+		}
+	})
+
+	return nil
+}
+
+// MarshalJSON marshals the bag as an object of {value: count} when the values can be object keys,
+// otherwise as an array of entries.
+func (b *Bag[T]) MarshalJSON() ([]byte, error) {
+	if textKeys[T]() {
+		return json.Marshal(b.snapshot())
+	}
+	return json.Marshal(b.Entries())
+}
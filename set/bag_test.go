@@ -0,0 +1,179 @@
+package gollections
+
+import (
+	"encoding/json"
+	"maps"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestBag(t *testing.T) {
+	b := BagFromSeq(slices.Values([]string{"a", "b", "a"}))
+	b.Add("c", 3)
+	b.Add("d", 0)
+	b.Add("d", -1)
+
+	tests := []struct {
+		value string
+		want  int
+	}{
+		{value: "a", want: 2},
+		{value: "b", want: 1},
+		{value: "c", want: 3},
+		{value: "d", want: 0},
+	}
+	for _, tt := range tests {
+		if got := b.Count(tt.value); got != tt.want {
+			t.Errorf("Count(%s) got %d, want %d", tt.value, got, tt.want)
+		}
+	}
+	if b.Size() != 6 {
+		t.Errorf("expected size 6, got %d", b.Size())
+	}
+	if got := slices.Sorted(b.Distinct().Values()); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("Distinct() got %v", got)
+	}
+
+	if n := b.Remove("c", 2); n != 2 || b.Count("c") != 1 {
+		t.Errorf("Remove() removed %d, count %d", n, b.Count("c"))
+	}
+	if n := b.Remove("a", 5); n != 2 || b.Contains("a") {
+		t.Errorf("Remove() removed %d, expected a to be gone", n)
+	}
+	if n := b.Remove("x", 1); n != 0 {
+		t.Errorf("Remove() of missing value removed %d", n)
+	}
+	if b.Size() != 2 {
+		t.Errorf("expected size 2, got %d", b.Size())
+	}
+
+	if got := slices.Sorted(b.Values()); !reflect.DeepEqual(got, []string{"b", "c"}) {
+		t.Errorf("Values() got %v", got)
+	}
+
+	b.Clear()
+	if b.Size() != 0 || b.Contains("b") {
+		t.Errorf("expected bag to be empty after Clear")
+	}
+}
+
+func TestBag_MostCommon(t *testing.T) {
+	b := BagFromSeq(slices.Values([]int{1, 2, 2, 3, 3, 3, 4, 4, 4, 4}))
+
+	tests := []struct {
+		name string
+		k    int
+		want []BagEntry[int]
+	}{
+		{name: "top-1", k: 1, want: []BagEntry[int]{{4, 4}}},
+		{name: "top-2", k: 2, want: []BagEntry[int]{{4, 4}, {3, 3}}},
+		{name: "all", k: 0, want: []BagEntry[int]{{4, 4}, {3, 3}, {2, 2}, {1, 1}}},
+		{name: "more than distinct", k: 10, want: []BagEntry[int]{{4, 4}, {3, 3}, {2, 2}, {1, 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := b.MostCommon(tt.k); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MostCommon(%d) got %v, want %v", tt.k, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBag_UnionIntersection(t *testing.T) {
+	a := BagFromSeq(slices.Values([]string{"x", "x", "y", "z"}))
+	b := BagFromSeq(slices.Values([]string{"x", "y", "y", "w"}))
+
+	u := a.Union(b)
+	if got := maps.Collect(u.All()); !reflect.DeepEqual(got, map[string]int{"x": 2, "y": 2, "z": 1, "w": 1}) {
+		t.Errorf("Union() got %v", got)
+	}
+	if u.Size() != 6 {
+		t.Errorf("Union() size got %d, want 6", u.Size())
+	}
+
+	i := a.Intersection(b)
+	if got := maps.Collect(i.All()); !reflect.DeepEqual(got, map[string]int{"x": 1, "y": 1}) {
+		t.Errorf("Intersection() got %v", got)
+	}
+	if i.Size() != 2 {
+		t.Errorf("Intersection() size got %d, want 2", i.Size())
+	}
+
+	if got := maps.Collect(a.Union(a).All()); !reflect.DeepEqual(got, maps.Collect(a.All())) {
+		t.Errorf("Union() with itself got %v", got)
+	}
+}
+
+func TestBag_JSON(t *testing.T) {
+	b := BagFromSeq(slices.Values([]string{"a", "b", "a"}))
+
+	d, err := json.Marshal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"a":2,"b":1}`; string(d) != want {
+		t.Errorf("MarshalJSON() got = %v, want %v", string(d), want)
+	}
+
+	var nb Bag[string]
+	if err := json.Unmarshal(d, &nb); err != nil {
+		t.Fatal(err)
+	}
+	if nb.Count("a") != 2 || nb.Count("b") != 1 || nb.Size() != 3 {
+		t.Errorf("UnmarshalJSON() got %v", maps.Collect(nb.All()))
+	}
+
+	type point struct {
+		X, Y int
+	}
+	p := NewBag[point]()
+	p.Add(point{1, 2}, 3)
+
+	d, err = json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `[{"value":{"X":1,"Y":2},"count":3}]`; string(d) != want {
+		t.Errorf("MarshalJSON() got = %v, want %v", string(d), want)
+	}
+
+	np := NewBag[point]()
+	if err := json.Unmarshal(d, np); err != nil {
+		t.Fatal(err)
+	}
+	if np.Count(point{1, 2}) != 3 {
+		t.Errorf("UnmarshalJSON() got %v", np.Entries())
+	}
+}
+
+func TestBag_ThreadSafety(t *testing.T) {
+	b := NewBag[int]()
+	wg := sync.WaitGroup{}
+	for i := range 10 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				b.Add(i, 2)
+				b.Remove(i, 1)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				b.Count(i)
+				b.MostCommon(1)
+				for range b.All() {
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if b.Size() != 1000 {
+		t.Errorf("expected size 1000, got %d", b.Size())
+	}
+}
@@ -0,0 +1,248 @@
+package gollections
+
+import (
+	"cmp"
+	"encoding/json"
+	"iter"
+	"sync"
+	"unsafe"
+
+	"github.com/johannessarpola/gollections/internal/seqs"
+)
+
+// ConcurrentSet is a set which is safe for concurrent use, reads take a shared lock
+// so readers do not block each other.
+type ConcurrentSet[T comparable] struct {
+	set Set[T]
+	mu  sync.RWMutex
+}
+
+// NewConcurrentSet creates a new ConcurrentSet.
+func NewConcurrentSet[T comparable](opts ...SetOpt[T]) *ConcurrentSet[T] {
+	return &ConcurrentSet[T]{set: *New(opts...)}
+}
+
+// ConcurrentFromSeq creates a new ConcurrentSet with the values of seq.
+func ConcurrentFromSeq[T comparable](seq iter.Seq[T], opts ...SetOpt[T]) *ConcurrentSet[T] {
+	return &ConcurrentSet[T]{set: *FromSeq(seq, opts...)}
+}
+
+func (s *ConcurrentSet[T]) withLock(f func()) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+	f()
+}
+
+func (s *ConcurrentSet[T]) withRLock(f func()) {
+	defer s.mu.RUnlock()
+	s.mu.RLock()
+	f()
+}
+
+// withOther runs f holding the lock of s, exclusively if write is set, and the shared lock of other.
+// The locks are taken in the order of the set addresses so concurrent calls with swapped operands
+// do not deadlock, and a set given twice is locked only once.
+func (s *ConcurrentSet[T]) withOther(other *ConcurrentSet[T], write bool, f func()) {
+	lock := s.withRLock
+	if write {
+		lock = s.withLock
+	}
+
+	switch {
+	case s == other:
+		lock(f)
+	case cmp.Less(uintptr(unsafe.Pointer(s)), uintptr(unsafe.Pointer(other))):
+		lock(func() { other.withRLock(f) })
+	default:
+		other.withRLock(func() { lock(f) })
+	}
+}
+
+func (s *ConcurrentSet[T]) AddAll(values ...T) {
+	s.withLock(func() {
+		s.set.AddAll(values...)
+	})
+}
+
+func (s *ConcurrentSet[T]) Unset(value T) {
+	s.withLock(func() {
+		s.set.Unset(value)
+	})
+}
+
+func (s *ConcurrentSet[T]) Add(value T) {
+	s.withLock(func() {
+		s.set.Add(value)
+	})
+}
+
+func (s *ConcurrentSet[T]) Contains(value T) bool {
+	b := false
+	s.withRLock(func() {
+		b = s.set.Contains(value)
+	})
+	return b
+}
+
+func (s *ConcurrentSet[T]) Remove(value T) bool {
+	b := false
+	s.withLock(func() {
+		b = s.set.Remove(value)
+	})
+	return b
+}
+
+func (s *ConcurrentSet[T]) Size() int {
+	i := 0
+	s.withRLock(func() {
+		i = s.set.Size()
+	})
+	return i
+}
+
+func (s *ConcurrentSet[T]) Clear() {
+	s.withLock(func() {
+		s.set.Clear()
+	})
+}
+
+func (s *ConcurrentSet[T]) Items() []T {
+	var items []T
+	s.withRLock(func() {
+		items = s.set.Items()
+	})
+	return items
+}
+
+// All iterates the values with an index, the order is not specified.
+func (s *ConcurrentSet[T]) All() iter.Seq2[int, T] {
+	return seqs.Snapshot(s.Items)
+}
+
+// Values iterates the values, the order is not specified.
+func (s *ConcurrentSet[T]) Values() iter.Seq[T] {
+	return seqs.Values(s.All())
+}
+
+// Union returns a new set with the values which are in either set.
+func (s *ConcurrentSet[T]) Union(other *ConcurrentSet[T]) *ConcurrentSet[T] {
+	rs := &ConcurrentSet[T]{}
+	s.withOther(other, false, func() {
+		rs.set = *s.set.Union(&other.set)
+	})
+	return rs
+}
+
+// Intersection returns a new set with the values which are in both sets.
+func (s *ConcurrentSet[T]) Intersection(other *ConcurrentSet[T]) *ConcurrentSet[T] {
+	rs := &ConcurrentSet[T]{}
+	s.withOther(other, false, func() {
+		rs.set = *s.set.Intersection(&other.set)
+	})
+	return rs
+}
+
+// Difference returns a new set with the values of s which are not in other.
+func (s *ConcurrentSet[T]) Difference(other *ConcurrentSet[T]) *ConcurrentSet[T] {
+	rs := &ConcurrentSet[T]{}
+	s.withOther(other, false, func() {
+		rs.set = *s.set.Difference(&other.set)
+	})
+	return rs
+}
+
+// SymmetricDifference returns a new set with the values which are in exactly one of the sets.
+func (s *ConcurrentSet[T]) SymmetricDifference(other *ConcurrentSet[T]) *ConcurrentSet[T] {
+	rs := &ConcurrentSet[T]{}
+	s.withOther(other, false, func() {
+		rs.set = *s.set.SymmetricDifference(&other.set)
+	})
+	return rs
+}
+
+// UnionWith adds the values of other to s.
+func (s *ConcurrentSet[T]) UnionWith(other *ConcurrentSet[T]) *ConcurrentSet[T] {
+	s.withOther(other, true, func() {
+		s.set.UnionWith(&other.set)
+	})
+	return s
+}
+
+// IntersectWith removes the values from s which are not in other.
+func (s *ConcurrentSet[T]) IntersectWith(other *ConcurrentSet[T]) *ConcurrentSet[T] {
+	s.withOther(other, true, func() {
+		s.set.IntersectWith(&other.set)
+	})
+	return s
+}
+
+// DifferenceWith removes the values of other from s.
+func (s *ConcurrentSet[T]) DifferenceWith(other *ConcurrentSet[T]) *ConcurrentSet[T] {
+	s.withOther(other, true, func() {
+		s.set.DifferenceWith(&other.set)
+	})
+	return s
+}
+
+// SymmetricDifferenceWith removes the values from s which are in other and adds the ones which are not.
+func (s *ConcurrentSet[T]) SymmetricDifferenceWith(other *ConcurrentSet[T]) *ConcurrentSet[T] {
+	s.withOther(other, true, func() {
+		s.set.SymmetricDifferenceWith(&other.set)
+	})
+	return s
+}
+
+// IsSubsetOf tells if all the values of s are in other.
+func (s *ConcurrentSet[T]) IsSubsetOf(other *ConcurrentSet[T]) bool {
+	b := false
+	s.withOther(other, false, func() {
+		b = s.set.IsSubsetOf(&other.set)
+	})
+	return b
+}
+
+// IsSupersetOf tells if all the values of other are in s.
+func (s *ConcurrentSet[T]) IsSupersetOf(other *ConcurrentSet[T]) bool {
+	return other.IsSubsetOf(s)
+}
+
+// IsDisjoint tells if the sets have no values in common.
+func (s *ConcurrentSet[T]) IsDisjoint(other *ConcurrentSet[T]) bool {
+	b := false
+	s.withOther(other, false, func() {
+		b = s.set.IsDisjoint(&other.set)
+	})
+	return b
+}
+
+// Equal tells if the sets have the same values.
+func (s *ConcurrentSet[T]) Equal(other *ConcurrentSet[T]) bool {
+	b := false
+	s.withOther(other, false, func() {
+		b = s.set.Equal(&other.set)
+	})
+	return b
+}
+
+func (s *ConcurrentSet[T]) UnmarshalJSON(data []byte) error {
+	var aux []T
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	s.AddAll(aux...)
+
+	return nil
+}
+
+func (s *ConcurrentSet[T]) MarshalJSON() ([]byte, error) {
+	var (
+		data []byte
+		err  error
+	)
+	s.withRLock(func() {
+		data, err = s.set.MarshalJSON()
+	})
+	return data, err
+}
@@ -0,0 +1,165 @@
+package gollections
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestConcurrentSet(t *testing.T) {
+	s := NewConcurrentSet[string]()
+	s.Add("a")
+	s.AddAll("b", "c")
+
+	if !s.Contains("a") || s.Contains("x") {
+		t.Errorf("unexpected Contains() result")
+	}
+	if s.Size() != 3 {
+		t.Errorf("expected size 3, got %d", s.Size())
+	}
+	if !s.Remove("a") || s.Remove("a") {
+		t.Errorf("expected a to be removed once")
+	}
+	s.Unset("b")
+	if got := slices.Sorted(s.Values()); !reflect.DeepEqual(got, []string{"c"}) {
+		t.Errorf("Values() got %v", got)
+	}
+
+	for v := range s.Values() {
+		s.Add(v + v)
+	}
+	if got := slices.Sorted(s.Values()); !reflect.DeepEqual(got, []string{"c", "cc"}) {
+		t.Errorf("got %v after modifying while iterating", got)
+	}
+
+	s.Clear()
+	if s.Size() != 0 {
+		t.Errorf("expected set to be empty after Clear")
+	}
+}
+
+func TestConcurrentSet_JSON(t *testing.T) {
+	s := ConcurrentFromSeq(slices.Values([]int{3, 1, 2}))
+
+	d, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ns ConcurrentSet[int]
+	if err := json.Unmarshal(d, &ns); err != nil {
+		t.Fatal(err)
+	}
+	if !ns.Equal(s) {
+		t.Errorf("UnmarshalJSON() got %v, want %v", ns.Items(), s.Items())
+	}
+}
+
+func TestConcurrentSet_Algebra(t *testing.T) {
+	a := ConcurrentFromSeq(slices.Values([]int{1, 2, 3}))
+	b := ConcurrentFromSeq(slices.Values([]int{2, 3, 4}))
+
+	tests := []struct {
+		name string
+		got  *ConcurrentSet[int]
+		want []int
+	}{
+		{name: "union", got: a.Union(b), want: []int{1, 2, 3, 4}},
+		{name: "intersection", got: a.Intersection(b), want: []int{2, 3}},
+		{name: "difference", got: a.Difference(b), want: []int{1}},
+		{name: "symmetric difference", got: a.SymmetricDifference(b), want: []int{1, 4}},
+		{name: "same set", got: a.Intersection(a), want: []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.Sorted(tt.got.Values()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if a.IsSubsetOf(b) || a.IsSupersetOf(b) || a.IsDisjoint(b) || a.Equal(b) || !a.Equal(a) {
+		t.Errorf("unexpected comparison result")
+	}
+
+	c := ConcurrentFromSeq(slices.Values([]int{1, 2}))
+	c.UnionWith(b).DifferenceWith(a).SymmetricDifferenceWith(c)
+	if c.Size() != 0 {
+		t.Errorf("expected set to be empty, got %v", c.Items())
+	}
+	c.AddAll(1, 5)
+	if got := slices.Sorted(c.IntersectWith(a).Values()); !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("IntersectWith() got %v", got)
+	}
+}
+
+// The tests below are meant to be run with the race detector.
+
+func TestConcurrentSet_ThreadSafety(t *testing.T) {
+	s := NewConcurrentSet[int]()
+	wg := sync.WaitGroup{}
+
+	for i := range 10 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := range 100 {
+				s.Add(i*100 + j)
+				if j%10 == 0 {
+					s.Remove(i*100 + j)
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := range 100 {
+				s.Contains(j)
+				s.Size()
+				s.Items()
+				for range s.Values() {
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if s.Size() != 900 {
+		t.Errorf("expected 900 values, got %d", s.Size())
+	}
+}
+
+func TestConcurrentSet_AlgebraConcurrent(t *testing.T) {
+	a := ConcurrentFromSeq(slices.Values([]int{1, 2, 3}))
+	b := ConcurrentFromSeq(slices.Values([]int{3, 4, 5}))
+
+	// swapped operands would deadlock if the locks were not ordered
+	wg := sync.WaitGroup{}
+	for i := range 100 {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			a.Union(b)
+			a.UnionWith(b)
+		}()
+		go func() {
+			defer wg.Done()
+			b.Intersection(a)
+			b.UnionWith(a)
+			b.IsSubsetOf(a)
+			a.Add(i + 10)
+		}()
+		go func() {
+			defer wg.Done()
+			a.UnionWith(a)
+			b.Equal(b)
+		}()
+	}
+	wg.Wait()
+
+	if !a.IsSupersetOf(ConcurrentFromSeq(slices.Values([]int{1, 2, 3, 4, 5}))) {
+		t.Errorf("got %v", a.Items())
+	}
+}
@@ -1,43 +1,89 @@
 package gollections
 
 import (
+	"cmp"
 	"encoding/json"
-	"sync"
+	"iter"
+	"slices"
+
+	"github.com/johannessarpola/gollections/internal/seqs"
 )
 
-// Set
+// Set is an unsynchronized set for use from a single goroutine, use ConcurrentSet
+// when the set is shared between goroutines.
 type Set[T comparable] struct {
 	internal map[T]struct{}
-	mu       sync.Mutex
+	compare  func(a, b T) int // orders the values for json when set
+}
+
+// SetOpts holds the configuration for a Set.
+type SetOpts[T comparable] struct {
+	Compare func(a, b T) int // order of the values in json, unordered when nil
+}
+
+// SetOpt represents a functional option for configuring the set.
+type SetOpt[T comparable] func(*SetOpts[T])
+
+// WithSortedJSON makes the set marshal its values in their natural order,
+// so the json is deterministic.
+func WithSortedJSON[T cmp.Ordered]() SetOpt[T] {
+	return WithSortedJSONFunc(cmp.Compare[T])
+}
+
+// WithSortedJSONFunc makes the set marshal its values in the order of compare.
+func WithSortedJSONFunc[T comparable](compare func(a, b T) int) SetOpt[T] {
+	return func(o *SetOpts[T]) {
+		o.Compare = compare
+	}
+}
+
+func argHandler[T comparable](opts []SetOpt[T]) SetOpts[T] {
+	args := SetOpts[T]{}
+	for _, opt := range opts {
+		opt(&args)
+	}
+	return args
 }
 
 // New creates a new Set.
-func New[T comparable]() *Set[T] {
-	return &Set[T]{internal: make(map[T]struct{})}
+func New[T comparable](opts ...SetOpt[T]) *Set[T] {
+	args := argHandler(opts)
+	return &Set[T]{
+		internal: make(map[T]struct{}),
+		compare:  args.Compare,
+	}
+}
+
+// FromSeq creates a new Set with the values of seq.
+func FromSeq[T comparable](seq iter.Seq[T], opts ...SetOpt[T]) *Set[T] {
+	s := New(opts...)
+	for v := range seq {
+		s.internal[v] = struct{}{}
+	}
+	return s
 }
 
-func (s *Set[T]) withLock(f func()) {
-	defer s.mu.Unlock()
-	s.mu.Lock()
-	f()
+// lazyInit creates the map for sets which were not created with a constructor.
+func (s *Set[T]) lazyInit() {
+	if s.internal == nil {
+		s.internal = make(map[T]struct{})
+	}
 }
 
 func (s *Set[T]) AddAll(values ...T) {
-	s.withLock(func() {
-		for _, v := range values {
-			s.internal[v] = struct{}{}
-		}
-	})
+	s.lazyInit()
+	for _, v := range values {
+		s.internal[v] = struct{}{}
+	}
 }
 
 func (s *Set[T]) Unset(value T) {
-	s.withLock(func() { delete(s.internal, value) })
+	delete(s.internal, value)
 }
 
 func (s *Set[T]) Add(value T) {
-	s.withLock(func() {
-		s.internal[value] = struct{}{}
-	})
+	s.lazyInit()
+	s.internal[value] = struct{}{}
 }
 
 func (s *Set[T]) Contains(value T) bool {
@@ -57,16 +103,14 @@ func (s *Set[T]) Size() int {
 	return len(s.internal)
 }
 
-func (s *Set[T]) All(yield func(int, T) bool) {
-	i := 0
-	s.withLock(func() {
-		for key := range s.internal {
-			if !yield(i, key) {
-				break
-			}
-			i++
-		}
-	})
+// All iterates the values with an index, the order is not specified.
+func (s *Set[T]) All() iter.Seq2[int, T] {
+	return seqs.Snapshot(s.Items)
+}
+
+// Values iterates the values, the order is not specified.
+func (s *Set[T]) Values() iter.Seq[T] {
+	return seqs.Values(s.All())
 }
 
 func (s *Set[T]) Clear() {
@@ -82,10 +126,6 @@ func (s *Set[T]) Items() []T {
 }
 
 func (c *Set[T]) UnmarshalJSON(data []byte) error {
-	if c.internal == nil {
-		c.internal = make(map[T]struct{})
-	}
-
 	var aux []T
 
 	if err := json.Unmarshal(data, &aux); err != nil {
@@ -97,7 +137,11 @@ func (c *Set[T]) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON marshals the values as an array, sorted when the set was created with WithSortedJSON.
 func (s *Set[T]) MarshalJSON() ([]byte, error) {
 	items := s.Items()
+	if s.compare != nil {
+		slices.SortFunc(items, s.compare)
+	}
 	return json.Marshal(items)
 }
@@ -2,6 +2,9 @@ package gollections
 
 import (
 	"encoding/json"
+	"maps"
+	"reflect"
+	"slices"
 	"testing"
 )
 
@@ -32,7 +35,7 @@ func TestSet(t *testing.T) {
 	}
 
 	cnt := 0
-	for range s.All {
+	for range s.All() {
 		cnt++
 	}
 
@@ -127,3 +130,45 @@ func TestSet(t *testing.T) {
 		t.Errorf("expected strings to be contained")
 	}
 }
+
+func TestSet_Iterators(t *testing.T) {
+	s := FromSeq(slices.Values([]int{1, 2, 2, 3}))
+
+	if s.Size() != 3 {
+		t.Errorf("expected size 3, got %d", s.Size())
+	}
+
+	got := slices.Sorted(s.Values())
+	if !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Values() got %v, want %v", got, []int{1, 2, 3})
+	}
+
+	idx := 0
+	for i := range s.All() {
+		if i != idx {
+			t.Errorf("All() got index %d, want %d", i, idx)
+		}
+		idx++
+	}
+
+	keys := FromSeq(maps.Keys(map[string]int{"a": 1, "b": 2}))
+	if !keys.Contains("a") || !keys.Contains("b") {
+		t.Errorf("expected map keys to be contained")
+	}
+}
+
+func TestSet_ModifyWhileIterating(t *testing.T) {
+	s := FromSeq(slices.Values([]int{1, 2, 3}))
+
+	for v := range s.Values() {
+		if !s.Contains(v) && v != 2 {
+			t.Errorf("expected %d to be contained", v)
+		}
+		s.Add(v * 10)
+		s.Remove(2)
+	}
+
+	if got := slices.Sorted(s.Values()); !reflect.DeepEqual(got, []int{1, 3, 10, 20, 30}) {
+		t.Errorf("got %v, want %v", got, []int{1, 3, 10, 20, 30})
+	}
+}
@@ -0,0 +1,116 @@
+package gollections
+
+import (
+	"cmp"
+	"encoding/json"
+	"iter"
+	"sync"
+
+	"github.com/johannessarpola/gollections/btree"
+)
+
+// SortedSet is a set which keeps its values in their natural order, it is backed by
+// a self-balancing binary tree so adding, removing and lookups are O(log n).
+type SortedSet[T cmp.Ordered] struct {
+	tree btree.BinaryTree[T]
+	once sync.Once
+}
+
+// NewSortedSet creates a new SortedSet.
+func NewSortedSet[T cmp.Ordered]() *SortedSet[T] {
+	return &SortedSet[T]{}
+}
+
+// SortedFromSeq creates a new SortedSet with the values of seq.
+func SortedFromSeq[T cmp.Ordered](seq iter.Seq[T]) *SortedSet[T] {
+	s := NewSortedSet[T]()
+	for v := range seq {
+		s.Add(v)
+	}
+	return s
+}
+
+// lazyInit configures the tree on first use, so the zero value is ready to use.
+func (s *SortedSet[T]) lazyInit() *btree.BinaryTree[T] {
+	s.once.Do(func() {
+		s.tree = btree.NewBinaryTree[T](
+			btree.WithSelfBalancing(),
+			btree.WithDuplicates(btree.RejectDuplicates),
+			btree.WithTraversalOrder(btree.InOrder),
+		)
+	})
+	return &s.tree
+}
+
+func (s *SortedSet[T]) AddAll(values ...T) {
+	s.lazyInit().Insert(values...)
+}
+
+func (s *SortedSet[T]) Add(value T) {
+	s.lazyInit().Insert(value)
+}
+
+func (s *SortedSet[T]) Contains(value T) bool {
+	_, b := s.lazyInit().Search(value)
+	return b
+}
+
+func (s *SortedSet[T]) Remove(value T) bool {
+	return s.lazyInit().Delete(value)
+}
+
+func (s *SortedSet[T]) Size() int {
+	return s.lazyInit().Size()
+}
+
+func (s *SortedSet[T]) Clear() {
+	s.lazyInit().Clear()
+}
+
+// First returns the smallest value.
+func (s *SortedSet[T]) First() (T, bool) {
+	return s.lazyInit().FindMin()
+}
+
+// Last returns the largest value.
+func (s *SortedSet[T]) Last() (T, bool) {
+	return s.lazyInit().FindMax()
+}
+
+// Range iterates the values in the half-open range [lo, hi) in ascending order.
+func (s *SortedSet[T]) Range(lo, hi T) iter.Seq[T] {
+	return s.lazyInit().Range(lo, hi)
+}
+
+// All iterates the values with their indexes in ascending order.
+func (s *SortedSet[T]) All() iter.Seq2[int, T] {
+	return s.lazyInit().All()
+}
+
+// Values iterates the values in ascending order.
+func (s *SortedSet[T]) Values() iter.Seq[T] {
+	return s.lazyInit().Values()
+}
+
+// Items returns the values in ascending order.
+func (s *SortedSet[T]) Items() []T {
+	return s.lazyInit().Items()
+}
+
+func (s *SortedSet[T]) UnmarshalJSON(data []byte) error {
+	var aux []T
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	s.AddAll(aux...)
+
+	return nil
+}
+
+// MarshalJSON marshals the values as an array in ascending order.
+func (s *SortedSet[T]) MarshalJSON() ([]byte, error) {
+	items := s.Items()
+	return json.Marshal(items)
+}
@@ -0,0 +1,121 @@
+package gollections
+
+import (
+	"cmp"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestSortedSet(t *testing.T) {
+	s := SortedFromSeq(slices.Values([]int{5, 1, 4, 1, 3}))
+	s.Add(2)
+	s.AddAll(4, 6)
+
+	if got := s.Items(); !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5, 6}) {
+		t.Errorf("Items() got %v", got)
+	}
+	if s.Size() != 6 {
+		t.Errorf("expected size 6, got %d", s.Size())
+	}
+	if !s.Contains(3) || s.Contains(7) {
+		t.Errorf("unexpected Contains() result")
+	}
+	if !s.Remove(3) || s.Remove(3) {
+		t.Errorf("expected 3 to be removed once")
+	}
+
+	if v, ok := s.First(); v != 1 || !ok {
+		t.Errorf("First() got %d (%v), want 1", v, ok)
+	}
+	if v, ok := s.Last(); v != 6 || !ok {
+		t.Errorf("Last() got %d (%v), want 6", v, ok)
+	}
+
+	tests := []struct {
+		name   string
+		lo, hi int
+		want   []int
+	}{
+		{name: "inner", lo: 2, hi: 5, want: []int{2, 4}},
+		{name: "all", lo: 0, hi: 10, want: []int{1, 2, 4, 5, 6}},
+		{name: "empty", lo: 7, hi: 9, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.Collect(s.Range(tt.lo, tt.hi)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Range(%d, %d) got %v, want %v", tt.lo, tt.hi, got, tt.want)
+			}
+		})
+	}
+
+	for i, v := range s.All() {
+		if i == 0 {
+			s.Add(0)
+		}
+		if v == 0 {
+			t.Errorf("expected iteration to not see values added during it")
+		}
+	}
+	if got := slices.Collect(s.Values()); !reflect.DeepEqual(got, []int{0, 1, 2, 4, 5, 6}) {
+		t.Errorf("Values() got %v", got)
+	}
+
+	s.Clear()
+	if _, ok := s.First(); ok || s.Size() != 0 {
+		t.Errorf("expected set to be empty after Clear")
+	}
+}
+
+func TestSortedSet_JSON(t *testing.T) {
+	type contained struct {
+		Field string            `json:"field"`
+		Set   SortedSet[string] `json:"set"`
+	}
+
+	var c contained
+	if err := json.Unmarshal([]byte(`{"field":"value","set":["c","a","b","a"]}`), &c); err != nil {
+		t.Fatal(err)
+	}
+	if got := c.Set.Items(); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("UnmarshalJSON() got %v", got)
+	}
+
+	d, err := json.Marshal(&c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"field":"value","set":["a","b","c"]}`; string(d) != want {
+		t.Errorf("MarshalJSON() got = %v, want %v", string(d), want)
+	}
+}
+
+func TestSet_SortedJSON(t *testing.T) {
+	values := []int{9, 3, 7, 1, 5, 8, 2}
+
+	s := FromSeq(slices.Values(values), WithSortedJSON[int]())
+	c := ConcurrentFromSeq(slices.Values(values), WithSortedJSON[int]())
+	want := "[1,2,3,5,7,8,9]"
+
+	// map iteration order is random, so marshal a few times
+	for range 10 {
+		if d, err := json.Marshal(s); err != nil || string(d) != want {
+			t.Fatalf("Set MarshalJSON() got = %v (%v), want %v", string(d), err, want)
+		}
+		if d, err := json.Marshal(c); err != nil || string(d) != want {
+			t.Fatalf("ConcurrentSet MarshalJSON() got = %v (%v), want %v", string(d), err, want)
+		}
+	}
+
+	u := s.Union(FromSeq(slices.Values([]int{4})))
+	if d, _ := json.Marshal(u); string(d) != "[1,2,3,4,5,7,8,9]" {
+		t.Errorf("expected the result of Union() to keep the sorted json, got %v", string(d))
+	}
+
+	desc := New(WithSortedJSONFunc(func(a, b string) int { return -cmp.Compare(a, b) }))
+	desc.AddAll("a", "c", "b")
+	if d, _ := json.Marshal(desc); string(d) != `["c","b","a"]` {
+		t.Errorf("MarshalJSON() got %v", string(d))
+	}
+}
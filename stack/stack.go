@@ -2,21 +2,36 @@ package gollections
 
 import (
 	"encoding/json"
+	"iter"
 	"slices"
 	"sync"
 
-	"github.com/johannessarpola/gollections/internal/node"
+	"github.com/johannessarpola/gollections/internal/ring"
+	"github.com/johannessarpola/gollections/internal/seqs"
 )
 
+// Stack LIFO data structure, backed by a ring buffer with the top at its back
 type Stack[T comparable] struct {
-	head *node.Node[T]
-	mu   sync.Mutex
+	values ring.Buffer[T]
+	mu     sync.Mutex
 }
 
 func NewStack[T comparable]() Stack[T] {
 	return Stack[T]{}
 }
 
+// FromSeq creates a new stack pushing the values of seq in order, the last value ends up on top.
+func FromSeq[T comparable](seq iter.Seq[T]) Stack[T] {
+	var values ring.Buffer[T]
+	for v := range seq {
+		values.PushBack(v)
+	}
+
+	return Stack[T]{
+		values: values,
+	}
+}
+
 func (s *Stack[T]) withLock(f func()) {
 	defer s.mu.Unlock()
 	s.mu.Lock()
@@ -29,9 +44,7 @@ func (s *Stack[T]) Peek() (T, bool) {
 		b bool
 	)
 	s.withLock(func() {
-		if s.head != nil {
-			v, b = s.head.Get()
-		}
+		v, b = s.values.Back()
 	})
 
 	return v, b
@@ -39,9 +52,7 @@ func (s *Stack[T]) Peek() (T, bool) {
 
 func (s *Stack[T]) Push(e T) {
 	s.withLock(func() {
-		n := node.NewNode(e)
-		n.Next = s.head
-		s.head = &n
+		s.values.PushBack(e)
 	})
 }
 
@@ -51,46 +62,34 @@ func (s *Stack[T]) Pop() (T, bool) {
 		b bool
 	)
 	s.withLock(func() {
-		if s.head != nil {
-			h := s.head
-			s.head = h.Next
-			v, _ = h.Get()
-			b = true
-		}
+		v, b = s.values.PopBack()
 	})
 	return v, b
 }
 
 func (s *Stack[T]) IsEmpty() bool {
-	b := false
+	return s.Size() == 0
+}
+
+func (s *Stack[T]) Size() int {
+	i := 0
 	s.withLock(func() {
-		b = s.head == nil
+		i = s.values.Len()
 	})
-	return b
+	return i
 }
 
-func (s *Stack[T]) PushAll(items ...T) {
-	if len(items) == 0 {
-		return
-	}
-
-	first := node.NewNode(items[0])
-	iteratee := &first
-	rest := items[1:]
-
-	for _, item := range rest {
-		node := node.NewNode(item)
-		node.Next = iteratee
-		iteratee = &node
-	}
+func (s *Stack[T]) Clear() {
+	s.withLock(func() {
+		s.values.Clear()
+	})
+}
 
+func (s *Stack[T]) PushAll(items ...T) {
 	s.withLock(func() {
-		if s.head == nil {
-			s.head = iteratee
-			return
+		for _, item := range items {
+			s.values.PushBack(item)
 		}
-		iteratee.Next = s.head
-		s.head = iteratee
 	})
 }
 
@@ -111,6 +110,31 @@ func (s *Stack[T]) PopAll() []T {
 	return rs
 }
 
+// snapshot copies the values from top to bottom while holding the lock.
+func (s *Stack[T]) snapshot() []T {
+	var values []T
+	s.withLock(func() {
+		values = s.values.Slice()
+	})
+	slices.Reverse(values)
+	return values
+}
+
+// All iterates the values with their indexes from the top to the bottom of the stack without popping them.
+func (s *Stack[T]) All() iter.Seq2[int, T] {
+	return seqs.Snapshot(s.snapshot)
+}
+
+// Values iterates the values from the top to the bottom of the stack without popping them.
+func (s *Stack[T]) Values() iter.Seq[T] {
+	return seqs.Values(s.All())
+}
+
+// Items returns the values from the top to the bottom of the stack without popping them.
+func (s *Stack[T]) Items() []T {
+	return s.snapshot()
+}
+
 func (s *Stack[T]) UnmarshalJSON(data []byte) error {
 	var aux []T
 
@@ -123,8 +147,10 @@ func (s *Stack[T]) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON marshals the values as an array from the bottom to the top of the stack,
+// the same order UnmarshalJSON pushes them in.
 func (s *Stack[T]) MarshalJSON() ([]byte, error) {
-	itemsReversed := s.PopAll()
-	slices.Reverse(itemsReversed)
-	return json.Marshal(itemsReversed)
+	items := s.snapshot()
+	slices.Reverse(items)
+	return json.Marshal(items)
 }
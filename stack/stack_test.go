@@ -3,6 +3,7 @@ package gollections
 import (
 	"encoding/json"
 	"reflect"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -199,3 +200,103 @@ func TestStack_JsonMarshalling(t *testing.T) {
 		t.Errorf("PopAll() = %v, want %v", c.Stack.PopAll(), want2)
 	}
 }
+
+func TestStack_Iterators(t *testing.T) {
+	stack := FromSeq(slices.Values([]int{1, 2, 3}))
+
+	if got := slices.Collect(stack.Values()); !reflect.DeepEqual(got, []int{3, 2, 1}) {
+		t.Errorf("Values() got %v, want %v", got, []int{3, 2, 1})
+	}
+
+	for i, v := range stack.All() {
+		if v != 3-i {
+			t.Errorf("All() got %d at index %d, want %d", v, i, 3-i)
+		}
+	}
+
+	// iteration does not pop
+	if v, ok := stack.Pop(); v != 3 || !ok {
+		t.Errorf("expected popped value to be 3, got %v", v)
+	}
+}
+
+func TestStack_ModifyWhileIterating(t *testing.T) {
+	stack := FromSeq(slices.Values([]int{1, 2, 3}))
+
+	for v := range stack.Values() {
+		if p, _ := stack.Pop(); p != v {
+			t.Errorf("expected to pop %d, got %d", v, p)
+		}
+	}
+
+	if !stack.IsEmpty() {
+		t.Errorf("expected stack to be empty")
+	}
+}
+
+func TestStack_ZeroValues(t *testing.T) {
+	s := NewStack[string]()
+	s.Push("a")
+	s.Push("")
+
+	// zero values are values like any other
+	if v, ok := s.Peek(); v != "" || !ok {
+		t.Errorf("Expected to peek empty string, but got %v (%v)", v, ok)
+	}
+	if v, ok := s.Pop(); v != "" || !ok {
+		t.Errorf("Expected to pop empty string, but got %v (%v)", v, ok)
+	}
+	if v, ok := s.Pop(); v != "a" || !ok {
+		t.Errorf("Expected to pop a, but got %v (%v)", v, ok)
+	}
+}
+
+func TestStack_MarshalJSON(t *testing.T) {
+	s := FromSeq(slices.Values([]int{1, 2, 3}))
+
+	d, err := json.Marshal(&s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(d) != "[1,2,3]" {
+		t.Errorf("MarshalJSON() got = %v, want %v", string(d), "[1,2,3]")
+	}
+
+	// marshalling leaves the stack as it was
+	if s.Size() != 3 {
+		t.Errorf("expected size 3 after marshalling, got %d", s.Size())
+	}
+	if got := s.Items(); !reflect.DeepEqual(got, []int{3, 2, 1}) {
+		t.Errorf("Items() got %v, want %v", got, []int{3, 2, 1})
+	}
+
+	var ns Stack[int]
+	if err := json.Unmarshal(d, &ns); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ns.Items(), s.Items()) {
+		t.Errorf("round trip got %v, want %v", ns.Items(), s.Items())
+	}
+}
+
+func TestStack_SizeAndClear(t *testing.T) {
+	s := NewStack[int]()
+	if s.Size() != 0 {
+		t.Errorf("expected size 0, got %d", s.Size())
+	}
+
+	s.PushAll(1, 2, 3)
+	s.Push(4)
+	s.Pop()
+	if s.Size() != 3 {
+		t.Errorf("expected size 3, got %d", s.Size())
+	}
+
+	s.Clear()
+	if !s.IsEmpty() || s.Size() != 0 {
+		t.Errorf("expected stack to be empty after Clear")
+	}
+	if _, ok := s.Peek(); ok {
+		t.Errorf("expected nothing to peek after Clear")
+	}
+}